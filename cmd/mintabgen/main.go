@@ -0,0 +1,183 @@
+// Mintabgen generates methods implementing mintab.Rower for struct types,
+// so that tables are loaded without reflection.
+//
+// Usage:
+//
+//	//go:generate mintabgen -type Instance,SecurityGroup
+//
+// For each type T, mintabgen writes t_mintab.go in the package directory.
+// Exported fields of string, bool, integer and float types, slices of strings and byte slices
+// are formatted like Table.Load does with the default options. Other fields are formatted with fmt.Sprint.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+func main() {
+	var (
+		types = flag.String("type", "", "comma-separated list of struct type names; required")
+		dir   = flag.String("dir", ".", "package directory")
+	)
+	flag.Parse()
+	if *types == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*dir, strings.Split(*types, ",")); err != nil {
+		fmt.Fprintln(os.Stderr, "mintabgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, names []string) error {
+	pkg, specs, err := parseDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		st, ok := specs[name]
+		if !ok {
+			return fmt.Errorf("struct type not found: %s", name)
+		}
+		src, err := generate(pkg, name, st)
+		if err != nil {
+			return err
+		}
+		out := filepath.Join(dir, strings.ToLower(name)+"_mintab.go")
+		if err := os.WriteFile(out, src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseDir(dir string) (string, map[string]*ast.StructType, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	var pkg string
+	specs := make(map[string]*ast.StructType)
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_mintab.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return "", nil, err
+		}
+		pkg = f.Name.Name
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if st, ok := ts.Type.(*ast.StructType); ok && ts.TypeParams == nil {
+				specs[ts.Name.Name] = st
+			}
+			return false
+		})
+	}
+	if pkg == "" {
+		return "", nil, fmt.Errorf("no go files in %s", dir)
+	}
+	return pkg, specs, nil
+}
+
+func generate(pkg, name string, st *ast.StructType) ([]byte, error) {
+	var (
+		header  []string
+		body    bytes.Buffer
+		imports []string
+	)
+	use := func(path string) {
+		if !slices.Contains(imports, path) {
+			imports = append(imports, path)
+		}
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			continue
+		}
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			header = append(header, ident.Name)
+			expr, ptr, pkgs := formatExpr(field.Type, "v."+ident.Name)
+			for _, p := range pkgs {
+				use(p)
+			}
+			if ptr {
+				fmt.Fprintf(&body, "\tif v.%s == nil {\n\t\tdst = append(dst, \"\")\n\t} else {\n\t\tdst = append(dst, %s)\n\t}\n", ident.Name, expr)
+				continue
+			}
+			fmt.Fprintf(&body, "\tdst = append(dst, %s)\n", expr)
+		}
+	}
+	if len(header) == 0 {
+		return nil, fmt.Errorf("at least one exported field is required: %s", name)
+	}
+	slices.Sort(imports)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by mintabgen; DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	if len(imports) > 0 {
+		b.WriteString("import (\n")
+		for _, p := range imports {
+			fmt.Fprintf(&b, "\t%q\n", p)
+		}
+		b.WriteString(")\n\n")
+	}
+	fmt.Fprintf(&b, "// MintabHeader implements mintab.Rower.\nfunc (v *%s) MintabHeader() []string {\n\treturn %#v\n}\n\n", name, header)
+	fmt.Fprintf(&b, "// MintabRow implements mintab.Rower.\nfunc (v *%s) MintabRow(dst []string) []string {\n%s\treturn dst\n}\n", name, body.String())
+	return format.Source(b.Bytes())
+}
+
+// formatExpr returns the expression formatting x of type typ, whether x is a pointer to be checked for nil,
+// and the packages imported by the expression.
+func formatExpr(typ ast.Expr, x string) (string, bool, []string) {
+	if star, ok := typ.(*ast.StarExpr); ok {
+		expr, _, pkgs := formatExpr(star.X, "*"+x)
+		return expr, true, pkgs
+	}
+	switch t := typ.(type) {
+	case *ast.Ident:
+		switch t.Name {
+		case "string":
+			return x, false, nil
+		case "bool":
+			return "strconv.FormatBool(" + x + ")", false, []string{"strconv"}
+		case "int", "int8", "int16", "int32", "int64":
+			return "strconv.FormatInt(int64(" + x + "), 10)", false, []string{"strconv"}
+		case "uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+			return "strconv.FormatUint(uint64(" + x + "), 10)", false, []string{"strconv"}
+		case "float32":
+			return "strconv.FormatFloat(float64(" + x + "), 'f', -1, 32)", false, []string{"strconv"}
+		case "float64":
+			return "strconv.FormatFloat(" + x + ", 'f', -1, 64)", false, []string{"strconv"}
+		}
+	case *ast.ArrayType:
+		ident, ok := t.Elt.(*ast.Ident)
+		switch {
+		case !ok || t.Len != nil:
+		case ident.Name == "string":
+			return "strings.Join(" + x + ", \"\\n\")", false, []string{"strings"}
+		case ident.Name == "byte" || ident.Name == "uint8":
+			return "string(" + x + ")", false, nil
+		}
+	}
+	return "fmt.Sprint(" + x + ")", false, []string{"fmt"}
+}
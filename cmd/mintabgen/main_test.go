@@ -0,0 +1,160 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRun(t *testing.T) {
+	type args struct {
+		src   string
+		names []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "basic",
+			args: args{
+				src: `package models
+
+import "time"
+
+type Instance struct {
+	InstanceID string
+	Name, Zone string
+	VCPU       int
+	Memory     float64
+	Running    bool
+	Tags       []string
+	PublicIP   *string
+	LaunchedAt time.Time
+	private    string
+}
+
+type Volume struct {
+	VolumeID string
+	Size     *uint64
+	Label    []byte
+	Raw      []uint8
+}
+`,
+				names: []string{"Instance", " Volume"},
+			},
+			want: map[string]string{
+				"instance_mintab.go": `// Code generated by mintabgen; DO NOT EDIT.
+
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MintabHeader implements mintab.Rower.
+func (v *Instance) MintabHeader() []string {
+	return []string{"InstanceID", "Name", "Zone", "VCPU", "Memory", "Running", "Tags", "PublicIP", "LaunchedAt"}
+}
+
+// MintabRow implements mintab.Rower.
+func (v *Instance) MintabRow(dst []string) []string {
+	dst = append(dst, v.InstanceID)
+	dst = append(dst, v.Name)
+	dst = append(dst, v.Zone)
+	dst = append(dst, strconv.FormatInt(int64(v.VCPU), 10))
+	dst = append(dst, strconv.FormatFloat(v.Memory, 'f', -1, 64))
+	dst = append(dst, strconv.FormatBool(v.Running))
+	dst = append(dst, strings.Join(v.Tags, "\n"))
+	if v.PublicIP == nil {
+		dst = append(dst, "")
+	} else {
+		dst = append(dst, *v.PublicIP)
+	}
+	dst = append(dst, fmt.Sprint(v.LaunchedAt))
+	return dst
+}
+`,
+				"volume_mintab.go": `// Code generated by mintabgen; DO NOT EDIT.
+
+package models
+
+import (
+	"strconv"
+)
+
+// MintabHeader implements mintab.Rower.
+func (v *Volume) MintabHeader() []string {
+	return []string{"VolumeID", "Size", "Label", "Raw"}
+}
+
+// MintabRow implements mintab.Rower.
+func (v *Volume) MintabRow(dst []string) []string {
+	dst = append(dst, v.VolumeID)
+	if v.Size == nil {
+		dst = append(dst, "")
+	} else {
+		dst = append(dst, strconv.FormatUint(uint64(*v.Size), 10))
+	}
+	dst = append(dst, string(v.Label))
+	dst = append(dst, string(v.Raw))
+	return dst
+}
+`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "not_found",
+			args: args{
+				src:   "package models\n\ntype Instance struct {\n\tName string\n}\n",
+				names: []string{"Volume"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no_exported_fields",
+			args: args{
+				src:   "package models\n\ntype Instance struct {\n\tname string\n}\n",
+				names: []string{"Instance"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "syntax_error",
+			args: args{
+				src:   "package models\n\ntype Instance struct {\n",
+				names: []string{"Instance"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "models.go"), []byte(tt.args.src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			err := run(dir, tt.args.names)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+				return
+			}
+			for name, want := range tt.want {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(string(got), want); diff != "" {
+					t.Error(diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package mintab
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// DefaultConversions is the default precedence of conversions of field values.
+var DefaultConversions = []Conversion{StringerConversion, ErrorConversion, TextMarshalerConversion}
+
+// A methodSet is a set of the interfaces of conversions implemented by a type.
+type methodSet uint8
+
+const (
+	stringerMethod methodSet = 1 << iota
+	errorMethod
+	textMarshalerMethod
+	jsonMarshalerMethod
+)
+
+// typeMethods holds the interfaces implemented by a type and those implemented only by its pointer.
+type typeMethods struct {
+	value   methodSet
+	pointer methodSet
+}
+
+var (
+	stringerType      = reflect.TypeFor[fmt.Stringer]()
+	errorType         = reflect.TypeFor[error]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+
+	methodCache sync.Map // map[reflect.Type]typeMethods
+)
+
+func (c Conversion) method() methodSet {
+	switch c {
+	case StringerConversion:
+		return stringerMethod
+	case ErrorConversion:
+		return errorMethod
+	case TextMarshalerConversion:
+		return textMarshalerMethod
+	case JSONMarshalerConversion:
+		return jsonMarshalerMethod
+	default:
+		return 0
+	}
+}
+
+// methodsOf returns the interfaces of conversions implemented by typ and its pointer, cached per type.
+func methodsOf(typ reflect.Type) typeMethods {
+	if m, ok := methodCache.Load(typ); ok {
+		return m.(typeMethods)
+	}
+	m := typeMethods{value: methodSetOf(typ)}
+	if typ.Kind() != reflect.Pointer {
+		m.pointer = methodSetOf(reflect.PointerTo(typ)) &^ m.value
+	}
+	methodCache.Store(typ, m)
+	return m
+}
+
+func methodSetOf(typ reflect.Type) methodSet {
+	if typ.NumMethod() == 0 {
+		return 0
+	}
+	var m methodSet
+	if typ.Implements(stringerType) {
+		m |= stringerMethod
+	}
+	if typ.Implements(errorType) {
+		m |= errorMethod
+	}
+	if typ.Implements(textMarshalerType) {
+		m |= textMarshalerMethod
+	}
+	if typ.Implements(jsonMarshalerType) {
+		m |= jsonMarshalerMethod
+	}
+	return m
+}
+
+// isPredeclared reports whether typ is a predeclared boolean, numeric or string type, which has no methods.
+func isPredeclared(typ reflect.Type) bool {
+	k := typ.Kind()
+	return typ.PkgPath() == "" && (k >= reflect.Bool && k <= reflect.Complex128 || k == reflect.String)
+}
+
+// convert converts rv through the interfaces of the conversions in order of precedence.
+// Methods with pointer receivers are also honored if rv is addressable.
+// Empty results are ignored so that the value falls back to the default formatting.
+// Whether the type implements each interface is decided before rv is converted to an interface value.
+func (t *Table) convert(rv reflect.Value) (string, bool, error) {
+	if rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return "", false, nil
+		}
+		rv = rv.Elem()
+	}
+	typ := rv.Type()
+	if isPredeclared(typ) || !rv.CanInterface() {
+		return "", false, nil
+	}
+	m := methodsOf(typ)
+	if !rv.CanAddr() {
+		m.pointer = 0
+	}
+	if m.value|m.pointer == 0 {
+		return "", false, nil
+	}
+	conversions := t.conversions
+	if conversions == nil {
+		conversions = DefaultConversions
+	}
+	var v, p any
+	for _, c := range conversions {
+		bit := c.method()
+		var x any
+		switch {
+		case m.value&bit != 0:
+			if v == nil {
+				v = rv.Interface()
+			}
+			x = v
+		case m.pointer&bit != 0:
+			if p == nil {
+				p = rv.Addr().Interface()
+			}
+			x = p
+		default:
+			continue
+		}
+		s, err := convertValue(c, x)
+		if err != nil {
+			return "", false, err
+		}
+		if s != "" {
+			return s, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+func convertValue(c Conversion, v any) (string, error) {
+	switch c {
+	case StringerConversion:
+		return v.(fmt.Stringer).String(), nil
+	case ErrorConversion:
+		return v.(error).Error(), nil
+	case TextMarshalerConversion:
+		b, err := v.(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", fmt.Errorf("cannot load input: failed to marshal text: %w", err)
+		}
+		return string(b), nil
+	case JSONMarshalerConversion:
+		b, err := v.(json.Marshaler).MarshalJSON()
+		if err != nil {
+			return "", fmt.Errorf("cannot load input: failed to marshal json: %w", err)
+		}
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return string(b), nil
+		}
+		return s, nil
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,172 @@
+package mintab
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type conversionTestState int
+
+func (s conversionTestState) MarshalText() ([]byte, error) {
+	switch s {
+	case 0:
+		return []byte("pending"), nil
+	case 1:
+		return []byte("running"), nil
+	default:
+		return nil, fmt.Errorf("unknown state: %d", int(s))
+	}
+}
+
+type conversionTestID struct {
+	n int
+}
+
+func (id *conversionTestID) String() string {
+	return fmt.Sprintf("id-%d", id.n)
+}
+
+type conversionTestError struct {
+	code int
+}
+
+func (e *conversionTestError) Error() string {
+	return fmt.Sprintf("error %d", e.code)
+}
+
+func (e *conversionTestError) String() string {
+	return fmt.Sprintf("E%d", e.code)
+}
+
+type conversionTestJSON struct {
+	v any
+}
+
+func (j conversionTestJSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.v)
+}
+
+func TestTable_convert(t *testing.T) {
+	type result struct {
+		ID     conversionTestID
+		State  conversionTestState
+		States []conversionTestState
+		Err    error
+		Errs   []error
+		JSON   conversionTestJSON
+	}
+	results := []result{
+		{
+			ID:     conversionTestID{n: 1},
+			State:  1,
+			States: []conversionTestState{0, 1},
+			Err:    &conversionTestError{code: 503},
+			Errs:   []error{errors.New("timeout"), nil},
+			JSON:   conversionTestJSON{v: "quoted"},
+		},
+		{
+			ID:   conversionTestID{n: 2},
+			JSON: conversionTestJSON{v: map[string]int{"a": 1}},
+		},
+	}
+	tests := []struct {
+		name    string
+		opts    []Option
+		v       any
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "default",
+			opts: []Option{WithFormat(CompressedTextFormat), WithIgnoreFields([]int{5})},
+			v:    results,
+			want: `+------+---------+---------+------+---------+
+| ID   | State   | States  | Err  | Errs    |
++------+---------+---------+------+---------+
+| id-1 | running | pending | E503 | timeout |
+|      |         | running |      | -       |
+| id-2 | pending | -       | -    | -       |
++------+---------+---------+------+---------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "order",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithConversions(ErrorConversion, JSONMarshalerConversion, TextMarshalerConversion),
+				WithIgnoreFields([]int{0, 2, 4}),
+			},
+			v: results,
+			want: `+---------+-----------+---------+
+| State   | Err       | JSON    |
++---------+-----------+---------+
+| running | error 503 | quoted  |
+| pending | -         | {"a":1} |
++---------+-----------+---------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "disabled",
+			opts: []Option{WithFormat(CompressedTextFormat), WithConversions(), WithIgnoreFields([]int{0, 2, 3, 4, 5})},
+			v:    results,
+			want: `+-------+
+| State |
++-------+
+|     1 |
+|     0 |
++-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "input",
+			opts: []Option{WithFormat(CompressedTextFormat)},
+			v: Input{
+				Header: []string{"State", "ID", "Err"},
+				Data: [][]any{
+					{conversionTestState(1), &conversionTestID{n: 3}, errors.New("failed")},
+				},
+			},
+			want: `+---------+------+--------+
+| State   | ID   | Err    |
++---------+------+--------+
+| running | id-3 | failed |
++---------+------+--------+
+`,
+			wantErr: false,
+		},
+		{
+			name:    "marshal_error",
+			opts:    []Option{WithIgnoreFields([]int{0, 2, 3, 4, 5})},
+			v:       []result{{State: 2}},
+			wantErr: true,
+		},
+		{
+			name:    "marshal_error_slice",
+			opts:    []Option{WithIgnoreFields([]int{0, 1, 3, 4, 5})},
+			v:       []result{{States: []conversionTestState{0, 2}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+				return
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package mintab
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const (
+	// DiffAdded is the marker for rows that exist only in the input after the change.
+	DiffAdded = "+"
+
+	// DiffRemoved is the marker for rows that exist only in the input before the change.
+	DiffRemoved = "-"
+
+	// DiffChanged is the marker for rows whose values differ between the inputs.
+	DiffChanged = "~"
+
+	// DiffArrow separates the old and new values of a changed field.
+	DiffArrow = " -> "
+)
+
+// A DiffChange holds the old and new values of a changed field in the Input returned by Diff.
+// Tables format both values like other fields and join them with DiffArrow,
+// and cell styles receive the DiffChange as the value to highlight changed fields.
+type DiffChange struct {
+	Old any // Value before the change
+	New any // Value after the change
+}
+
+var diffChangeType = reflect.TypeFor[DiffChange]()
+
+// String returns the old and new values joined by DiffArrow.
+func (c DiffChange) String() string {
+	return toString(c.Old) + DiffArrow + toString(c.New)
+}
+
+// Diff compares before and after, which must have the same header, and returns an Input describing
+// the rows that were removed, changed or added. Rows are matched by the values of keys, which
+// should be unique within each input. The first column holds DiffRemoved, DiffChanged or DiffAdded,
+// and changed fields are DiffChange values rendered as "old -> new". Unchanged rows are omitted.
+// before and after take the same forms as Table.Load.
+func Diff(before, after any, keys []string) (Input, error) {
+	old, err := toInput(before)
+	if err != nil {
+		return Input{}, err
+	}
+	cur, err := toInput(after)
+	if err != nil {
+		return Input{}, err
+	}
+	header := cur.Header
+	if header == nil {
+		header = old.Header
+	} else if old.Header != nil && !slices.Equal(old.Header, cur.Header) {
+		return Input{}, fmt.Errorf("cannot diff input: header must be the same")
+	}
+	if len(keys) == 0 {
+		return Input{}, fmt.Errorf("cannot diff input: at least one key is required")
+	}
+	indices := make([]int, len(keys))
+	for i, k := range keys {
+		if indices[i] = slices.Index(header, k); indices[i] < 0 {
+			return Input{}, fmt.Errorf("cannot diff input: column not found: %q", k)
+		}
+	}
+	for _, in := range []Input{old, cur} {
+		for _, r := range in.Data {
+			if len(r) != len(header) {
+				return Input{}, fmt.Errorf("cannot diff input: number of columns must be the same as header")
+			}
+		}
+	}
+	pos := make(map[string]int, len(cur.Data))
+	for i, r := range cur.Data {
+		pos[diffKey(r, indices)] = i
+	}
+	seen := make([]bool, len(cur.Data))
+	out := Input{
+		Header: append([]string{""}, header...),
+	}
+	for _, r := range old.Data {
+		i, ok := pos[diffKey(r, indices)]
+		if !ok {
+			out.Data = append(out.Data, append([]any{DiffRemoved}, r...))
+			continue
+		}
+		seen[i] = true
+		row, changed := diffRow(r, cur.Data[i])
+		if changed {
+			out.Data = append(out.Data, row)
+		}
+	}
+	for i, r := range cur.Data {
+		if !seen[i] {
+			out.Data = append(out.Data, append([]any{DiffAdded}, r...))
+		}
+	}
+	return out, nil
+}
+
+func diffKey(row []any, indices []int) string {
+	var b strings.Builder
+	for i, j := range indices {
+		if i > 0 {
+			b.WriteByte(0)
+		}
+		b.WriteString(toString(row[j]))
+	}
+	return b.String()
+}
+
+func diffRow(before, after []any) ([]any, bool) {
+	row := make([]any, len(after)+1)
+	row[0] = DiffChanged
+	changed := false
+	for i := range after {
+		if toString(before[i]) == toString(after[i]) {
+			row[i+1] = after[i]
+			continue
+		}
+		row[i+1] = DiffChange{Old: before[i], New: after[i]}
+		changed = true
+	}
+	return row, changed
+}
@@ -0,0 +1,282 @@
+package mintab
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type diffTestStruct struct {
+	SG        string
+	Direction string
+	Protocol  string
+	Port      int
+	CidrBlock string
+}
+
+func TestDiff(t *testing.T) {
+	before := []diffTestStruct{
+		{SG: "sg-1", Direction: "Ingress", Protocol: "tcp", Port: 22, CidrBlock: "10.0.0.0/8"},
+		{SG: "sg-1", Direction: "Egress", Protocol: "-1", Port: 0, CidrBlock: "0.0.0.0/0"},
+		{SG: "sg-2", Direction: "Ingress", Protocol: "tcp", Port: 443, CidrBlock: "0.0.0.0/0"},
+	}
+	after := []diffTestStruct{
+		{SG: "sg-1", Direction: "Ingress", Protocol: "tcp", Port: 22, CidrBlock: "10.1.0.0/16"},
+		{SG: "sg-2", Direction: "Ingress", Protocol: "tcp", Port: 443, CidrBlock: "0.0.0.0/0"},
+		{SG: "sg-3", Direction: "Ingress", Protocol: "tcp", Port: 3389, CidrBlock: "10.1.0.0/16"},
+	}
+	type args struct {
+		before any
+		after  any
+		keys   []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Input
+		wantErr bool
+	}{
+		{
+			name: "struct",
+			args: args{
+				before: before,
+				after:  after,
+				keys:   []string{"SG", "Direction"},
+			},
+			want: Input{
+				Header: []string{"", "SG", "Direction", "Protocol", "Port", "CidrBlock"},
+				Data: [][]any{
+					{DiffChanged, "sg-1", "Ingress", "tcp", 22, DiffChange{Old: "10.0.0.0/8", New: "10.1.0.0/16"}},
+					{DiffRemoved, "sg-1", "Egress", "-1", 0, "0.0.0.0/0"},
+					{DiffAdded, "sg-3", "Ingress", "tcp", 3389, "10.1.0.0/16"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "input",
+			args: args{
+				before: Input{
+					Header: []string{"Name", "Count"},
+					Data:   [][]any{{"a", 1}, {"b", 2}},
+				},
+				after: &Input{
+					Header: []string{"Name", "Count"},
+					Data:   [][]any{{"a", 1}, {"b", 3}},
+				},
+				keys: []string{"Name"},
+			},
+			want: Input{
+				Header: []string{"", "Name", "Count"},
+				Data: [][]any{
+					{DiffChanged, "b", DiffChange{Old: 2, New: 3}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no_before",
+			args: args{
+				before: nil,
+				after:  after[:1],
+				keys:   []string{"SG"},
+			},
+			want: Input{
+				Header: []string{"", "SG", "Direction", "Protocol", "Port", "CidrBlock"},
+				Data: [][]any{
+					{DiffAdded, "sg-1", "Ingress", "tcp", 22, "10.1.0.0/16"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "row_provider",
+			args: args{
+				before: &ringTestProvider{
+					header: []string{"Name", "Count"},
+					buf:    [][]any{{"a", 1}, {"b", 2}},
+				},
+				after: &ringTestProvider{
+					header: []string{"Name", "Count"},
+					buf:    [][]any{{"c", 3}, {"b", 2}, {"a", 0}},
+					start:  1,
+				},
+				keys: []string{"Name"},
+			},
+			want: Input{
+				Header: []string{"", "Name", "Count"},
+				Data: [][]any{
+					{DiffChanged, "a", DiffChange{Old: 1, New: 0}},
+					{DiffAdded, "c", 3},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil_pointer_before",
+			args: args{
+				before: (*diffTestStruct)(nil),
+				after:  &after[0],
+				keys:   []string{"SG"},
+			},
+			want: Input{
+				Header: []string{"", "SG", "Direction", "Protocol", "Port", "CidrBlock"},
+				Data: [][]any{
+					{DiffAdded, "sg-1", "Ingress", "tcp", 22, "10.1.0.0/16"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil_pointer_after",
+			args: args{
+				before: &before[1],
+				after:  (*diffTestStruct)(nil),
+				keys:   []string{"SG"},
+			},
+			want: Input{
+				Header: []string{"", "SG", "Direction", "Protocol", "Port", "CidrBlock"},
+				Data: [][]any{
+					{DiffRemoved, "sg-1", "Egress", "-1", 0, "0.0.0.0/0"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "different_header",
+			args: args{
+				before: Input{Header: []string{"A"}},
+				after:  Input{Header: []string{"B"}},
+				keys:   []string{"A"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no_keys",
+			args: args{
+				before: before,
+				after:  after,
+			},
+			wantErr: true,
+		},
+		{
+			name: "key_not_found",
+			args: args{
+				before: before,
+				after:  after,
+				keys:   []string{"Instance"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_columns",
+			args: args{
+				before: Input{Header: []string{"A", "B"}, Data: [][]any{{"a"}}},
+				after:  Input{Header: []string{"A", "B"}},
+				keys:   []string{"A"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_input",
+			args: args{
+				before: []any{1},
+				after:  after,
+				keys:   []string{"SG"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(tt.args.before, tt.args.after, tt.args.keys)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestDiff_render(t *testing.T) {
+	in, err := Diff(
+		Input{
+			Header: []string{"SG", "Port", "CidrBlock", "Tags"},
+			Data: [][]any{
+				{"sg-1", 22, "10.0.0.0/8", []string{"a", "b"}},
+				{"sg-2", 1234567, "0.0.0.0/0", []string{"a"}},
+			},
+		},
+		Input{
+			Header: []string{"SG", "Port", "CidrBlock", "Tags"},
+			Data: [][]any{
+				{"sg-1", 23, "10.1.0.0/16", []string{"a", "c"}},
+				{"sg-3", 80, "0.0.0.0/0", nil},
+			},
+		},
+		[]string{"SG"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "text",
+			opts: []Option{WithFormat(CompressedTextFormat), WithNumberFormat(NumberFormat{Thousands: ","})},
+			want: `+---+------+-----------+---------------------------+------+
+|   | SG   | Port      | CidrBlock                 | Tags |
++---+------+-----------+---------------------------+------+
+| ~ | sg-1 |  22 -> 23 | 10.0.0.0/8 -> 10.1.0.0/16 | a    |
+|   |      |           |                           | b    |
+|   |      |           |                           | -> a |
+|   |      |           |                           | c    |
+| - | sg-2 | 1,234,567 | 0.0.0.0/0                 | a    |
+| + | sg-3 |        80 | 0.0.0.0/0                 | -    |
++---+------+-----------+---------------------------+------+
+`,
+		},
+		{
+			name: "highlight",
+			opts: []Option{
+				WithFormat(MarkdownFormat),
+				WithIgnoreFields([]int{3, 4}),
+				WithCellStyle(func(row, col int, value any) Style {
+					if _, ok := value.(DiffChange); ok {
+						return Style{Bold: true}
+					}
+					return Style{}
+				}),
+			},
+			want: `|   | SG   | Port         |
+|---|------|--------------|
+| ~ | sg-1 | **22 -> 23** |
+| - | sg-2 |      1234567 |
+| + | sg-3 |           80 |
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(in); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,12 @@ const (
 
 	// BacklogFormat is backlog-specific table format.
 	BacklogFormat
+
+	// VerticalFormat is expanded record format where each row is rendered as a block of header and value lines.
+	VerticalFormat
+
+	// PlainFormat is borderless format with space-aligned columns.
+	PlainFormat
 )
 
 // MarshalJSON marshals a Format into JSON.
@@ -38,6 +44,10 @@ func (t Format) String() string {
 		return "markdown"
 	case BacklogFormat:
 		return "backlog"
+	case VerticalFormat:
+		return "vertical"
+	case PlainFormat:
+		return "plain"
 	default:
 		return ""
 	}
@@ -54,7 +64,344 @@ func ParseFormat(s string) (Format, error) {
 		return MarkdownFormat, nil
 	case BacklogFormat.String():
 		return BacklogFormat, nil
+	case VerticalFormat.String():
+		return VerticalFormat, nil
+	case PlainFormat.String():
+		return PlainFormat, nil
 	default:
 		return 0, fmt.Errorf("unsupported format: %q", s)
 	}
 }
+
+// A Separator represents when borders are drawn between data rows in text formats.
+type Separator int
+
+const (
+	// AutoSeparator draws borders based on the format:
+	// every row in text table format and merge group boundaries in compressed text table format.
+	AutoSeparator Separator = iota
+
+	// EverySeparator draws a border between every data row.
+	EverySeparator
+
+	// IntervalSeparator draws a border every N data rows.
+	IntervalSeparator
+
+	// GroupSeparator draws a border only at merge group boundaries.
+	GroupSeparator
+
+	// NoSeparator draws no border between data rows.
+	NoSeparator
+)
+
+// MarshalJSON marshals a Separator into JSON.
+func (t Separator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String returns the string representation of a Separator.
+func (t Separator) String() string {
+	switch t {
+	case AutoSeparator:
+		return "auto"
+	case EverySeparator:
+		return "every"
+	case IntervalSeparator:
+		return "interval"
+	case GroupSeparator:
+		return "group"
+	case NoSeparator:
+		return "none"
+	default:
+		return ""
+	}
+}
+
+// ParseSeparator parses a string into a Separator.
+func ParseSeparator(s string) (Separator, error) {
+	switch s {
+	case AutoSeparator.String():
+		return AutoSeparator, nil
+	case EverySeparator.String():
+		return EverySeparator, nil
+	case IntervalSeparator.String():
+		return IntervalSeparator, nil
+	case GroupSeparator.String():
+		return GroupSeparator, nil
+	case NoSeparator.String():
+		return NoSeparator, nil
+	default:
+		return 0, fmt.Errorf("unsupported separator: %q", s)
+	}
+}
+
+// A Theme represents a named preset of format, border characters, row separators, header style and margins.
+type Theme int
+
+const (
+	// DefaultTheme is text table format with the default options.
+	DefaultTheme Theme = iota
+
+	// PsqlTheme is similar to the output of psql, without the outer frame.
+	PsqlTheme
+
+	// MysqlTheme is similar to the output of the mysql client, with no borders between data rows.
+	MysqlTheme
+
+	// GithubTheme is GitHub flavored markdown table format.
+	GithubTheme
+
+	// CompactTheme is text table format without margins and borders between data rows, with a bold header.
+	CompactTheme
+
+	// MinimalTheme is borderless format with space-aligned columns, similar to the output of kubectl get.
+	MinimalTheme
+)
+
+// MarshalJSON marshals a Theme into JSON.
+func (t Theme) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String returns the string representation of a Theme.
+func (t Theme) String() string {
+	switch t {
+	case DefaultTheme:
+		return "default"
+	case PsqlTheme:
+		return "psql"
+	case MysqlTheme:
+		return "mysql"
+	case GithubTheme:
+		return "github"
+	case CompactTheme:
+		return "compact"
+	case MinimalTheme:
+		return "minimal"
+	default:
+		return ""
+	}
+}
+
+// ParseTheme parses a string into a Theme.
+func ParseTheme(s string) (Theme, error) {
+	switch s {
+	case DefaultTheme.String():
+		return DefaultTheme, nil
+	case PsqlTheme.String():
+		return PsqlTheme, nil
+	case MysqlTheme.String():
+		return MysqlTheme, nil
+	case GithubTheme.String():
+		return GithubTheme, nil
+	case CompactTheme.String():
+		return CompactTheme, nil
+	case MinimalTheme.String():
+		return MinimalTheme, nil
+	default:
+		return 0, fmt.Errorf("unsupported theme: %q", s)
+	}
+}
+
+// A Conversion represents an interface through which field values are converted to strings.
+type Conversion int
+
+const (
+	// StringerConversion converts values implementing fmt.Stringer with String.
+	StringerConversion Conversion = iota
+
+	// ErrorConversion converts values implementing error with Error.
+	ErrorConversion
+
+	// TextMarshalerConversion converts values implementing encoding.TextMarshaler with MarshalText.
+	TextMarshalerConversion
+
+	// JSONMarshalerConversion converts values implementing json.Marshaler with MarshalJSON.
+	// JSON strings are unquoted.
+	JSONMarshalerConversion
+)
+
+// MarshalJSON marshals a Conversion into JSON.
+func (t Conversion) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String returns the string representation of a Conversion.
+func (t Conversion) String() string {
+	switch t {
+	case StringerConversion:
+		return "stringer"
+	case ErrorConversion:
+		return "error"
+	case TextMarshalerConversion:
+		return "text"
+	case JSONMarshalerConversion:
+		return "json"
+	default:
+		return ""
+	}
+}
+
+// ParseConversion parses a string into a Conversion.
+func ParseConversion(s string) (Conversion, error) {
+	switch s {
+	case StringerConversion.String():
+		return StringerConversion, nil
+	case ErrorConversion.String():
+		return ErrorConversion, nil
+	case TextMarshalerConversion.String():
+		return TextMarshalerConversion, nil
+	case JSONMarshalerConversion.String():
+		return JSONMarshalerConversion, nil
+	default:
+		return 0, fmt.Errorf("unsupported conversion: %q", s)
+	}
+}
+
+// A Unit represents the prefixes by which numeric values are scaled.
+type Unit int
+
+const (
+	// NoUnit leaves numeric values unscaled.
+	NoUnit Unit = iota
+
+	// SIUnit scales numeric values by powers of 1000 with SI prefixes such as k, M and G.
+	SIUnit
+
+	// IECUnit scales numeric values by powers of 1024 with IEC prefixes such as Ki, Mi and Gi.
+	IECUnit
+)
+
+// MarshalJSON marshals a Unit into JSON.
+func (t Unit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String returns the string representation of a Unit.
+func (t Unit) String() string {
+	switch t {
+	case NoUnit:
+		return "none"
+	case SIUnit:
+		return "si"
+	case IECUnit:
+		return "iec"
+	default:
+		return ""
+	}
+}
+
+// ParseUnit parses a string into a Unit.
+func ParseUnit(s string) (Unit, error) {
+	switch s {
+	case NoUnit.String():
+		return NoUnit, nil
+	case SIUnit.String():
+		return SIUnit, nil
+	case IECUnit.String():
+		return IECUnit, nil
+	default:
+		return 0, fmt.Errorf("unsupported unit: %q", s)
+	}
+}
+
+// A ColumnType represents how the fields of a column are aligned.
+type ColumnType int
+
+const (
+	// AutoColumnType right-aligns fields detected as numbers.
+	AutoColumnType ColumnType = iota
+
+	// StringColumnType left-aligns all fields, for columns of numeric identifiers such as account IDs and zip codes.
+	StringColumnType
+
+	// NumberColumnType right-aligns all fields.
+	NumberColumnType
+)
+
+// MarshalJSON marshals a ColumnType into JSON.
+func (t ColumnType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String returns the string representation of a ColumnType.
+func (t ColumnType) String() string {
+	switch t {
+	case AutoColumnType:
+		return "auto"
+	case StringColumnType:
+		return "string"
+	case NumberColumnType:
+		return "number"
+	default:
+		return ""
+	}
+}
+
+// ParseColumnType parses a string into a ColumnType.
+func ParseColumnType(s string) (ColumnType, error) {
+	switch s {
+	case AutoColumnType.String():
+		return AutoColumnType, nil
+	case StringColumnType.String():
+		return StringColumnType, nil
+	case NumberColumnType.String():
+		return NumberColumnType, nil
+	default:
+		return 0, fmt.Errorf("unsupported column type: %q", s)
+	}
+}
+
+// A BoolFormat represents how bool values are rendered.
+type BoolFormat int
+
+const (
+	// TrueFalseBoolFormat renders bool values as true and false.
+	TrueFalseBoolFormat BoolFormat = iota
+
+	// YesNoBoolFormat renders bool values as yes and no.
+	YesNoBoolFormat
+
+	// CheckBoolFormat renders bool values as a check mark and a cross.
+	CheckBoolFormat
+
+	// CheckOnlyBoolFormat renders true as a check mark and false as the placeholder.
+	CheckOnlyBoolFormat
+)
+
+// MarshalJSON marshals a BoolFormat into JSON.
+func (t BoolFormat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// String returns the string representation of a BoolFormat.
+func (t BoolFormat) String() string {
+	switch t {
+	case TrueFalseBoolFormat:
+		return "truefalse"
+	case YesNoBoolFormat:
+		return "yesno"
+	case CheckBoolFormat:
+		return "check"
+	case CheckOnlyBoolFormat:
+		return "checkonly"
+	default:
+		return ""
+	}
+}
+
+// ParseBoolFormat parses a string into a BoolFormat.
+func ParseBoolFormat(s string) (BoolFormat, error) {
+	switch s {
+	case TrueFalseBoolFormat.String():
+		return TrueFalseBoolFormat, nil
+	case YesNoBoolFormat.String():
+		return YesNoBoolFormat, nil
+	case CheckBoolFormat.String():
+		return CheckBoolFormat, nil
+	case CheckOnlyBoolFormat.String():
+		return CheckOnlyBoolFormat, nil
+	default:
+		return 0, fmt.Errorf("unsupported bool format: %q", s)
+	}
+}
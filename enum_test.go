@@ -30,6 +30,16 @@ func TestFormat_String(t *testing.T) {
 			o:    BacklogFormat,
 			want: "backlog",
 		},
+		{
+			name: "vertical",
+			o:    VerticalFormat,
+			want: "vertical",
+		},
+		{
+			name: "plain",
+			o:    PlainFormat,
+			want: "plain",
+		},
 		{
 			name: "other",
 			o:    9,
@@ -79,6 +89,18 @@ func TestParseFormat(t *testing.T) {
 			want:    BacklogFormat,
 			wantErr: false,
 		},
+		{
+			name:    "parse vertical",
+			args:    args{s: "vertical"},
+			want:    VerticalFormat,
+			wantErr: false,
+		},
+		{
+			name:    "parse plain",
+			args:    args{s: "plain"},
+			want:    PlainFormat,
+			wantErr: false,
+		},
 		{
 			name:    "invalid format",
 			args:    args{s: "invalid"},
@@ -111,3 +133,590 @@ func TestParseFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestSeparator_String(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Separator
+		want string
+	}{
+		{
+			name: "auto",
+			o:    AutoSeparator,
+			want: "auto",
+		},
+		{
+			name: "every",
+			o:    EverySeparator,
+			want: "every",
+		},
+		{
+			name: "interval",
+			o:    IntervalSeparator,
+			want: "interval",
+		},
+		{
+			name: "group",
+			o:    GroupSeparator,
+			want: "group",
+		},
+		{
+			name: "none",
+			o:    NoSeparator,
+			want: "none",
+		},
+		{
+			name: "other",
+			o:    9,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("Separator.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSeparator(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Separator
+		wantErr bool
+	}{
+		{
+			name:    "parse auto",
+			args:    args{s: "auto"},
+			want:    AutoSeparator,
+			wantErr: false,
+		},
+		{
+			name:    "parse every",
+			args:    args{s: "every"},
+			want:    EverySeparator,
+			wantErr: false,
+		},
+		{
+			name:    "parse interval",
+			args:    args{s: "interval"},
+			want:    IntervalSeparator,
+			wantErr: false,
+		},
+		{
+			name:    "parse group",
+			args:    args{s: "group"},
+			want:    GroupSeparator,
+			wantErr: false,
+		},
+		{
+			name:    "parse none",
+			args:    args{s: "none"},
+			want:    NoSeparator,
+			wantErr: false,
+		},
+		{
+			name:    "invalid separator",
+			args:    args{s: "invalid"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSeparator(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSeparator() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseSeparator() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTheme_String(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Theme
+		want string
+	}{
+		{
+			name: "default",
+			o:    DefaultTheme,
+			want: "default",
+		},
+		{
+			name: "psql",
+			o:    PsqlTheme,
+			want: "psql",
+		},
+		{
+			name: "mysql",
+			o:    MysqlTheme,
+			want: "mysql",
+		},
+		{
+			name: "github",
+			o:    GithubTheme,
+			want: "github",
+		},
+		{
+			name: "compact",
+			o:    CompactTheme,
+			want: "compact",
+		},
+		{
+			name: "minimal",
+			o:    MinimalTheme,
+			want: "minimal",
+		},
+		{
+			name: "other",
+			o:    9,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("Theme.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTheme(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Theme
+		wantErr bool
+	}{
+		{
+			name:    "parse default",
+			args:    args{s: "default"},
+			want:    DefaultTheme,
+			wantErr: false,
+		},
+		{
+			name:    "parse psql",
+			args:    args{s: "psql"},
+			want:    PsqlTheme,
+			wantErr: false,
+		},
+		{
+			name:    "parse mysql",
+			args:    args{s: "mysql"},
+			want:    MysqlTheme,
+			wantErr: false,
+		},
+		{
+			name:    "parse github",
+			args:    args{s: "github"},
+			want:    GithubTheme,
+			wantErr: false,
+		},
+		{
+			name:    "parse compact",
+			args:    args{s: "compact"},
+			want:    CompactTheme,
+			wantErr: false,
+		},
+		{
+			name:    "parse minimal",
+			args:    args{s: "minimal"},
+			want:    MinimalTheme,
+			wantErr: false,
+		},
+		{
+			name:    "invalid theme",
+			args:    args{s: "invalid"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTheme(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseTheme() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseTheme() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConversion_String(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Conversion
+		want string
+	}{
+		{
+			name: "stringer",
+			o:    StringerConversion,
+			want: "stringer",
+		},
+		{
+			name: "error",
+			o:    ErrorConversion,
+			want: "error",
+		},
+		{
+			name: "text",
+			o:    TextMarshalerConversion,
+			want: "text",
+		},
+		{
+			name: "json",
+			o:    JSONMarshalerConversion,
+			want: "json",
+		},
+		{
+			name: "other",
+			o:    9,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("Conversion.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConversion(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Conversion
+		wantErr bool
+	}{
+		{
+			name:    "parse stringer",
+			args:    args{s: "stringer"},
+			want:    StringerConversion,
+			wantErr: false,
+		},
+		{
+			name:    "parse error",
+			args:    args{s: "error"},
+			want:    ErrorConversion,
+			wantErr: false,
+		},
+		{
+			name:    "parse text",
+			args:    args{s: "text"},
+			want:    TextMarshalerConversion,
+			wantErr: false,
+		},
+		{
+			name:    "parse json",
+			args:    args{s: "json"},
+			want:    JSONMarshalerConversion,
+			wantErr: false,
+		},
+		{
+			name:    "invalid conversion",
+			args:    args{s: "invalid"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseConversion(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseConversion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseConversion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnit_String(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Unit
+		want string
+	}{
+		{
+			name: "none",
+			o:    NoUnit,
+			want: "none",
+		},
+		{
+			name: "si",
+			o:    SIUnit,
+			want: "si",
+		},
+		{
+			name: "iec",
+			o:    IECUnit,
+			want: "iec",
+		},
+		{
+			name: "other",
+			o:    9,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("Unit.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Unit
+		wantErr bool
+	}{
+		{
+			name:    "parse none",
+			args:    args{s: "none"},
+			want:    NoUnit,
+			wantErr: false,
+		},
+		{
+			name:    "parse si",
+			args:    args{s: "si"},
+			want:    SIUnit,
+			wantErr: false,
+		},
+		{
+			name:    "parse iec",
+			args:    args{s: "iec"},
+			want:    IECUnit,
+			wantErr: false,
+		},
+		{
+			name:    "invalid unit",
+			args:    args{s: "invalid"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseUnit(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnType_String(t *testing.T) {
+	tests := []struct {
+		name string
+		o    ColumnType
+		want string
+	}{
+		{
+			name: "auto",
+			o:    AutoColumnType,
+			want: "auto",
+		},
+		{
+			name: "string",
+			o:    StringColumnType,
+			want: "string",
+		},
+		{
+			name: "number",
+			o:    NumberColumnType,
+			want: "number",
+		},
+		{
+			name: "other",
+			o:    9,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("ColumnType.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseColumnType(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    ColumnType
+		wantErr bool
+	}{
+		{
+			name:    "parse auto",
+			args:    args{s: "auto"},
+			want:    AutoColumnType,
+			wantErr: false,
+		},
+		{
+			name:    "parse string",
+			args:    args{s: "string"},
+			want:    StringColumnType,
+			wantErr: false,
+		},
+		{
+			name:    "parse number",
+			args:    args{s: "number"},
+			want:    NumberColumnType,
+			wantErr: false,
+		},
+		{
+			name:    "invalid column type",
+			args:    args{s: "invalid"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseColumnType(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseColumnType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseColumnType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoolFormat_String(t *testing.T) {
+	tests := []struct {
+		name string
+		o    BoolFormat
+		want string
+	}{
+		{
+			name: "truefalse",
+			o:    TrueFalseBoolFormat,
+			want: "truefalse",
+		},
+		{
+			name: "yesno",
+			o:    YesNoBoolFormat,
+			want: "yesno",
+		},
+		{
+			name: "check",
+			o:    CheckBoolFormat,
+			want: "check",
+		},
+		{
+			name: "checkonly",
+			o:    CheckOnlyBoolFormat,
+			want: "checkonly",
+		},
+		{
+			name: "other",
+			o:    9,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.o.String(); got != tt.want {
+				t.Errorf("BoolFormat.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBoolFormat(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    BoolFormat
+		wantErr bool
+	}{
+		{
+			name:    "parse truefalse",
+			args:    args{s: "truefalse"},
+			want:    TrueFalseBoolFormat,
+			wantErr: false,
+		},
+		{
+			name:    "parse yesno",
+			args:    args{s: "yesno"},
+			want:    YesNoBoolFormat,
+			wantErr: false,
+		},
+		{
+			name:    "parse check",
+			args:    args{s: "check"},
+			want:    CheckBoolFormat,
+			wantErr: false,
+		},
+		{
+			name:    "parse checkonly",
+			args:    args{s: "checkonly"},
+			want:    CheckOnlyBoolFormat,
+			wantErr: false,
+		},
+		{
+			name:    "invalid bool format",
+			args:    args{s: "invalid"},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBoolFormat(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBoolFormat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseBoolFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
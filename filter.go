@@ -0,0 +1,158 @@
+package mintab
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A Filter reports whether a row should be rendered.
+// header holds the names of all columns, including ignored ones, and row holds the raw values in the same order.
+type Filter func(header []string, row []any) bool
+
+var filterOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+// ParseFilter parses an expression such as `State == "running"` or `Port > 1024` into a Filter.
+// The expression consists of a column name, an operator (==, !=, <, <=, >, >=) and a value.
+// Quoted values are compared as strings, and unquoted numbers are compared numerically when
+// the field value is numeric. A row whose header does not contain the column is filtered out.
+func ParseFilter(expr string) (Filter, error) {
+	s := strings.TrimSpace(expr)
+	i := strings.IndexAny(s, "=!<>")
+	if i <= 0 {
+		return nil, fmt.Errorf("invalid filter expression: %q", expr)
+	}
+	name := strings.TrimSpace(s[:i])
+	rest := s[i:]
+	op := ""
+	for _, o := range filterOperators {
+		if strings.HasPrefix(rest, o) {
+			op = o
+			break
+		}
+	}
+	if op == "" {
+		return nil, fmt.Errorf("invalid filter operator: %q", expr)
+	}
+	lit := strings.TrimSpace(rest[len(op):])
+	if lit == "" {
+		return nil, fmt.Errorf("invalid filter value: %q", expr)
+	}
+	var (
+		str       string
+		num       float64
+		isNumeric bool
+	)
+	switch lit[0] {
+	case '"', '`':
+		v, err := strconv.Unquote(lit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter value: %q", expr)
+		}
+		str = v
+	case '\'':
+		if len(lit) < 2 || lit[len(lit)-1] != '\'' {
+			return nil, fmt.Errorf("invalid filter value: %q", expr)
+		}
+		str = lit[1 : len(lit)-1]
+	default:
+		str = lit
+		if f, err := strconv.ParseFloat(lit, 64); err == nil {
+			num = f
+			isNumeric = true
+		}
+	}
+	return func(header []string, row []any) bool {
+		j := slices.Index(header, name)
+		if j < 0 || j >= len(row) {
+			return false
+		}
+		if isNumeric {
+			if f, ok := toFloat(row[j]); ok {
+				return compare(op, cmpFloat(f, num))
+			}
+		}
+		return compare(op, strings.Compare(toString(row[j]), str))
+	}, nil
+}
+
+func (t *Table) filter(header []string, row []any) bool {
+	for _, f := range t.filters {
+		if !f(header, row) {
+			return false
+		}
+	}
+	return true
+}
+
+func compare(op string, c int) bool {
+	switch op {
+	case "==":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	default:
+		return false
+	}
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toFloat(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return 0, false
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(strings.TrimSpace(rv.String()), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toString(v any) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return ""
+	}
+	if s, ok := rv.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprint(rv.Interface())
+}
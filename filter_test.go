@@ -0,0 +1,243 @@
+package mintab
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFilter(t *testing.T) {
+	header := []string{"Name", "State", "Port"}
+	row := []any{"web", "running", 8080}
+	type args struct {
+		expr string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "string_equal",
+			args:    args{expr: `State == "running"`},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "string_not_equal",
+			args:    args{expr: `State != 'running'`},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:    "bare_string",
+			args:    args{expr: `Name==web`},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "number_greater",
+			args:    args{expr: `Port > 1024`},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "number_less_equal",
+			args:    args{expr: `Port <= 1024`},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:    "number_greater_equal",
+			args:    args{expr: `Port >= 8080`},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name:    "number_less",
+			args:    args{expr: `Port < 8080`},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:    "unknown_column",
+			args:    args{expr: `Unknown == "x"`},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name:    "no_column",
+			args:    args{expr: `== "x"`},
+			wantErr: true,
+		},
+		{
+			name:    "no_operator",
+			args:    args{expr: `State running`},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_operator",
+			args:    args{expr: `State =~ running`},
+			wantErr: true,
+		},
+		{
+			name:    "no_value",
+			args:    args{expr: `State ==`},
+			wantErr: true,
+		},
+		{
+			name:    "unterminated_quote",
+			args:    args{expr: `State == "running`},
+			wantErr: true,
+		},
+		{
+			name:    "unterminated_single_quote",
+			args:    args{expr: `State == 'running`},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ParseFilter(tt.args.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseFilter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got := f(header, row); got != tt.want {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_filter(t *testing.T) {
+	running, _ := ParseFilter(`State == "running"`)
+	highPort, _ := ParseFilter(`Port > 1024`)
+	type row struct {
+		Name  string
+		State string
+		Port  *int
+	}
+	ip := func(i int) *int {
+		return &i
+	}
+	type args struct {
+		opts []Option
+		v    any
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "input",
+			args: args{
+				opts: []Option{WithFilter(running, highPort)},
+				v: Input{
+					Header: []string{"Name", "State", "Port"},
+					Data: [][]any{
+						{"web", "running", 8080},
+						{"db", "running", 443},
+						{"cache", "stopped", 6379},
+						{"api", "running", 9000},
+					},
+				},
+			},
+			want: `+------+---------+------+
+| Name | State   | Port |
++------+---------+------+
+| web  | running | 8080 |
++------+---------+------+
+| api  | running | 9000 |
++------+---------+------+
+`,
+		},
+		{
+			name: "input_ignored_column",
+			args: args{
+				opts: []Option{WithFilter(running), WithIgnoreFields([]int{1})},
+				v: Input{
+					Header: []string{"Name", "State", "Port"},
+					Data: [][]any{
+						{"web", "running", 8080},
+						{"cache", "stopped", 6379},
+					},
+				},
+			},
+			want: `+------+------+
+| Name | Port |
++------+------+
+| web  | 8080 |
++------+------+
+`,
+		},
+		{
+			name: "struct",
+			args: args{
+				opts: []Option{WithFilter(highPort)},
+				v: []row{
+					{Name: "web", State: "running", Port: ip(8080)},
+					{Name: "db", State: "running", Port: ip(443)},
+					{Name: "none", State: "stopped", Port: nil},
+				},
+			},
+			want: `+------+---------+------+
+| Name | State   | Port |
++------+---------+------+
+| web  | running | 8080 |
++------+---------+------+
+`,
+		},
+		{
+			name: "predicate",
+			args: args{
+				opts: []Option{
+					WithFilter(func(header []string, row []any) bool {
+						return row[0] != "db"
+					}),
+					WithFormat(MarkdownFormat),
+				},
+				v: []row{
+					{Name: "web", State: "running", Port: ip(8080)},
+					{Name: "db", State: "running", Port: ip(443)},
+				},
+			},
+			want: `| Name | State   | Port |
+|------|---------|------|
+| web  | running | 8080 |
+`,
+		},
+		{
+			name: "all_filtered",
+			args: args{
+				opts: []Option{WithFilter(running)},
+				v: Input{
+					Header: []string{"Name", "State", "Port"},
+					Data: [][]any{
+						{"cache", "stopped", 6379},
+					},
+				},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.args.opts...)
+			if err := table.Load(tt.args.v); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -1,13 +1,12 @@
 package mintab
 
 import (
+	"cmp"
 	"fmt"
 	"reflect"
 	"slices"
 	"strconv"
 	"strings"
-
-	"github.com/mattn/go-runewidth"
 )
 
 // Load validates v and converts it to a struct Table. v must be passed in one of the following two ways:
@@ -20,7 +19,31 @@ import (
 //   - If a struct is passed, it is converted to a slice with one element.
 //   - If the field is a slice with primitive data type or a slice of byte slice, it is converted to a string.
 //   - If the field is struct, an error is returned (nested structs are not supported)
+//   - If the struct or its pointer implements Rower, fields are formatted by its methods without reflection.
+//
+// 3. Any type implementing `mintab.RowProvider`
+//   - Rows are loaded in the order of the iterator as with `mintab.Input`.
+//
+// If WithKeyValue is enabled, a single struct or map is instead loaded as a two-column Key/Value table,
+// with nested structs and maps flattened into dotted keys.
+//
+// Any previously loaded header and data are discarded, and nothing is left loaded if an error is returned.
+// If Input has a header but no data, only the header is loaded so that rows can be appended later with AppendRow.
+//
+// Load, Reset, AppendRow, AppendRows and rendering methods are safe for concurrent use.
 func (t *Table) Load(v any) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.load(v)
+}
+
+func (t *Table) load(v any) (err error) {
+	t.reset()
+	defer func() {
+		if err != nil {
+			t.reset()
+		}
+	}()
 	if _, ok := v.([]any); ok {
 		return fmt.Errorf("cannot load input: elements of slice must not be any")
 	}
@@ -35,7 +58,16 @@ func (t *Table) Load(v any) error {
 		if err := t.loadInput(*tv); err != nil {
 			return err
 		}
+	case RowProvider:
+		if err := t.loadRows(tv); err != nil {
+			return err
+		}
 	default:
+		if t.isKeyValue {
+			if in, ok := keyValueInput(tv); ok {
+				return t.loadInput(in)
+			}
+		}
 		if err := t.loadStruct(tv); err != nil {
 			return err
 		}
@@ -43,9 +75,270 @@ func (t *Table) Load(v any) error {
 	return nil
 }
 
+// Reset discards the loaded header and data while keeping the options,
+// so that the table can be reused. Allocations are retained for subsequent loads.
+func (t *Table) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.reset()
+}
+
+func (t *Table) reset() {
+	t.header = t.header[:0]
+	t.inputHeader = nil
+	t.structType = nil
+	t.fieldNames = t.fieldNames[:0]
+	t.isRower = false
+	t.inputColumns = t.inputColumns[:0]
+	t.data = t.data[:0]
+	t.unstyled = t.unstyled[:0]
+	t.rawRows = t.rawRows[:0]
+	t.isSorted = false
+	t.colWidths = t.colWidths[:0]
+	t.intWidths = t.intWidths[:0]
+	t.fracWidths = t.fracWidths[:0]
+	t.lineHeights = t.lineHeights[:0]
+	t.prevRow = t.prevRow[:0]
+	t.numColumns = 0
+	t.numColumnsFirstRow = 0
+	t.numRows = 0
+	t.border = ""
+	t.tableWidth = 0
+	t.isMerge = false
+	t.written = 0
+	t.err = nil
+}
+
+// AppendRow appends a row to the table loaded with Input. The row must have as many fields as the header,
+// and column widths are updated incrementally. Merging, filtering and styling are applied as in Load.
+// AppendRow may be called from multiple goroutines; use WithSortFunc to get a deterministic row order.
+func (t *Table) AppendRow(row []any) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.structType != nil || t.inputHeader == nil {
+		return fmt.Errorf("cannot append row: header must be loaded with Input first")
+	}
+	err := t.appendInputRow(row)
+	t.commitRows()
+	return err
+}
+
+// AppendRows appends rows to the table. v must be Input or RowProvider with the same header as the loaded one
+// (or no header for Input), or a struct slice of the same type as the loaded one. If nothing is loaded yet, AppendRows is equivalent to Load.
+func (t *Table) AppendRows(v any) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.inputHeader == nil && t.structType == nil {
+		return t.load(v)
+	}
+	var err error
+	switch tv := v.(type) {
+	case nil:
+		return nil
+	case Input:
+		err = t.appendInput(tv)
+	case *Input:
+		if tv != nil {
+			err = t.appendInput(*tv)
+		}
+	case RowProvider:
+		err = t.appendRows(tv)
+	default:
+		err = t.appendStruct(tv)
+	}
+	t.commitRows()
+	return err
+}
+
+func (t *Table) appendInput(v Input) error {
+	if t.structType != nil {
+		return fmt.Errorf("cannot append rows: input cannot be appended to a table loaded from structs")
+	}
+	if len(v.Header) > 0 && !slices.Equal(v.Header, t.inputHeader) {
+		return fmt.Errorf("cannot append rows: header must be the same as the loaded header")
+	}
+	return t.appendInputRows(v.Data)
+}
+
+func (t *Table) appendStruct(v any) error {
+	if t.structType == nil {
+		return fmt.Errorf("cannot append rows: structs cannot be appended to a table loaded from input")
+	}
+	rv := structSlice(v)
+	if !rv.IsValid() || rv.Len() == 0 {
+		return nil
+	}
+	typ := rv.Type().Elem()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ != t.structType {
+		return fmt.Errorf("cannot append rows: elements of slice must be %s", t.structType)
+	}
+	return t.appendStructRows(rv)
+}
+
+func (t *Table) commitRows() {
+	if t.sortFunc != nil {
+		t.numRows = len(t.rawRows)
+		t.isSorted = false
+		return
+	}
+	t.numRows = len(t.data)
+	t.setBorder()
+}
+
+// sortRows rebuilds the data from the raw rows in the order of the sort function.
+func (t *Table) sortRows() {
+	if t.sortFunc == nil || t.isSorted {
+		return
+	}
+	slices.SortStableFunc(t.rawRows, func(a, b rawRow) int {
+		return t.sortFunc(a.cells, b.cells)
+	})
+	for i, h := range t.header {
+		t.colWidths[i] = stringWidth(h)
+	}
+	t.intWidths = t.intWidths[:0]
+	t.fracWidths = t.fracWidths[:0]
+	t.resetData(len(t.rawRows))
+	for _, r := range t.rawRows {
+		t.addRow(r)
+	}
+	t.numRows = len(t.data)
+	t.isSorted = true
+	t.setBorder()
+}
+
+func keyValueInput(v any) (Input, bool) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return Input{}, false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct && rv.Kind() != reflect.Map {
+		return Input{}, false
+	}
+	in := Input{
+		Header: []string{"Key", "Value"},
+	}
+	flatten(&in.Data, "", reflect.ValueOf(v), make(map[visit]struct{}))
+	return in, true
+}
+
+// A visit is a pointer or map on the path from the root of the value being flattened.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// flatten appends the leaves of rv with dotted keys to data. Pointers and maps already on the path
+// in visiting are rendered as their addresses to stop at cycles such as parent pointers.
+func flatten(data *[][]any, key string, rv reflect.Value, visiting map[visit]struct{}) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			*data = append(*data, []any{key, nil})
+			return
+		}
+		if rv.Kind() == reflect.Pointer {
+			v := visit{rv.Pointer(), rv.Type()}
+			if _, ok := visiting[v]; ok {
+				*data = append(*data, []any{key, fmt.Sprintf("%p", rv.Interface())})
+				return
+			}
+			visiting[v] = struct{}{}
+			defer delete(visiting, v)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() == reflect.Map && !rv.IsNil() {
+		v := visit{rv.Pointer(), rv.Type()}
+		if _, ok := visiting[v]; ok {
+			*data = append(*data, []any{key, fmt.Sprintf("%p", rv.Interface())})
+			return
+		}
+		visiting[v] = struct{}{}
+		defer delete(visiting, v)
+	}
+	switch {
+	case rv.Kind() == reflect.Struct && !hasStringer(rv.Type()):
+		typ := rv.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			f := rv.Field(i)
+			k := joinKey(key, field.Name)
+			if field.Anonymous {
+				if f.Kind() == reflect.Pointer && f.IsNil() {
+					continue
+				}
+				if reflect.Indirect(f).Kind() == reflect.Struct {
+					k = key
+				}
+			}
+			flatten(data, k, f, visiting)
+		}
+	case rv.Kind() == reflect.Map:
+		if rv.Len() == 0 {
+			if key != "" {
+				*data = append(*data, []any{key, nil})
+			}
+			return
+		}
+		keys := rv.MapKeys()
+		names := make([]string, len(keys))
+		for i, k := range keys {
+			names[i] = fmt.Sprint(k.Interface())
+		}
+		order := make([]int, len(keys))
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortFunc(order, func(a, b int) int {
+			return compareKeys(keys[a], keys[b], names[a], names[b])
+		})
+		for _, i := range order {
+			flatten(data, joinKey(key, names[i]), rv.MapIndex(keys[i]), visiting)
+		}
+	default:
+		*data = append(*data, []any{key, rv.Interface()})
+	}
+}
+
+// compareKeys compares map keys by value if their kind is ordered, or by their names otherwise.
+func compareKeys(a, b reflect.Value, aName, bName string) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return strings.Compare(a.String(), b.String())
+	default:
+		return strings.Compare(aName, bName)
+	}
+}
+
+func joinKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func hasStringer(typ reflect.Type) bool {
+	stringer := reflect.TypeFor[fmt.Stringer]()
+	return typ.Implements(stringer) || reflect.PointerTo(typ).Implements(stringer)
+}
+
 func (t *Table) loadInput(v Input) error {
-	t.numRows = len(v.Data)
-	if t.numRows == 0 {
+	if len(v.Data) == 0 && len(v.Header) == 0 {
 		return nil
 	}
 	t.setFormat()
@@ -55,25 +348,16 @@ func (t *Table) loadInput(v Input) error {
 	if err := t.setInputData(v); err != nil {
 		return err
 	}
-	t.setBorder()
+	t.commitRows()
 	return nil
 }
 
 func (t *Table) loadStruct(v any) error {
-	rv := reflect.ValueOf(v)
-	if rv.Kind() == reflect.Pointer {
-		rv = rv.Elem()
-	}
-	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
-		if rv.IsZero() {
-			return nil
-		}
-		rv = reflect.Append(reflect.MakeSlice(reflect.SliceOf(rv.Type()), 0, 1), rv)
-	}
-	t.numRows = rv.Len()
-	if t.numRows == 0 {
+	rv := structSlice(v)
+	if !rv.IsValid() || rv.Len() == 0 {
 		return nil
 	}
+	t.numRows = rv.Len()
 	t.setFormat()
 	if err := t.setStructHeader(rv); err != nil {
 		return err
@@ -81,10 +365,24 @@ func (t *Table) loadStruct(v any) error {
 	if err := t.setStructData(rv); err != nil {
 		return err
 	}
-	t.setBorder()
+	t.commitRows()
 	return nil
 }
 
+func structSlice(v any) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		if !rv.IsValid() || rv.IsZero() {
+			return reflect.Value{}
+		}
+		rv = reflect.Append(reflect.MakeSlice(reflect.SliceOf(rv.Type()), 0, 1), rv)
+	}
+	return rv
+}
+
 func (t *Table) setFormat() {
 	var p, d string
 	switch t.format {
@@ -106,7 +404,7 @@ func (t *Table) setFormat() {
 	if t.wordDelimiter == TextDefaultWordDelimiter {
 		t.wordDelimiter = d
 	}
-	if t.format != TextFormat {
+	if t.format != TextFormat && t.format != VerticalFormat && t.format != PlainFormat {
 		t.r = strings.NewReplacer("\n", t.newLine)
 	}
 }
@@ -116,23 +414,36 @@ func (t *Table) setInputHeader(v Input) error {
 		return fmt.Errorf("cannot load input: header is required")
 	}
 	t.numColumns = len(v.Header)
-	firstRow := v.Data[0]
-	t.numColumnsFirstRow = len(firstRow)
+	t.numColumnsFirstRow = t.numColumns
+	if len(v.Data) > 0 {
+		t.numColumnsFirstRow = len(v.Data[0])
+	}
 	if t.numColumns != t.numColumnsFirstRow {
 		return fmt.Errorf("cannot load input: number of columns must be the same as header")
 	}
-	t.header = make([]string, 0, t.numColumns)
-	t.colWidths = make([]int, 0, t.numColumns)
+	t.inputHeader = v.Header
+	t.header = slices.Grow(t.header[:0], t.numColumns)
+	t.inputColumns = slices.Grow(t.inputColumns[:0], t.numColumns)
+	t.colWidths = slices.Grow(t.colWidths[:0], t.numColumns)
 	for i, h := range v.Header {
 		if !slices.Contains(t.ignoredFields, i) {
+			t.inputColumns = append(t.inputColumns, i)
+			h = t.headerName(h)
 			t.header = append(t.header, h)
-			t.colWidths = append(t.colWidths, runewidth.StringWidth(h))
+			t.colWidths = append(t.colWidths, stringWidth(h))
 		}
 	}
 	t.numColumns = len(t.colWidths)
 	return nil
 }
 
+func (t *Table) headerName(h string) string {
+	if t.isUpperCaseHeader {
+		return strings.ToUpper(h)
+	}
+	return h
+}
+
 func (t *Table) setStructHeader(rv reflect.Value) error {
 	e := rv.Index(0)
 	if e.Kind() == reflect.Pointer {
@@ -142,14 +453,21 @@ func (t *Table) setStructHeader(rv reflect.Value) error {
 		return fmt.Errorf("cannot load input: elements of slice must be struct or pointer to struct")
 	}
 	typ := e.Type()
+	t.structType = typ
+	if isRower(typ) {
+		return t.setRowerHeader(e)
+	}
 	t.numColumns = typ.NumField()
-	t.header = make([]string, 0, t.numColumns)
-	t.colWidths = make([]int, 0, t.numColumns)
+	t.header = slices.Grow(t.header[:0], t.numColumns)
+	t.fieldNames = slices.Grow(t.fieldNames[:0], t.numColumns)
+	t.colWidths = slices.Grow(t.colWidths[:0], t.numColumns)
 	for i := 0; i < t.numColumns; i++ {
 		field := typ.Field(i)
 		if !slices.Contains(t.ignoredFields, i) && field.PkgPath == "" {
-			t.header = append(t.header, field.Name)
-			t.colWidths = append(t.colWidths, runewidth.StringWidth(field.Name))
+			t.fieldNames = append(t.fieldNames, field.Name)
+			h := t.headerName(field.Name)
+			t.header = append(t.header, h)
+			t.colWidths = append(t.colWidths, stringWidth(h))
 		}
 	}
 	t.numColumns = len(t.colWidths)
@@ -160,73 +478,187 @@ func (t *Table) setStructHeader(rv reflect.Value) error {
 }
 
 func (t *Table) setInputData(v Input) error {
-	t.data = make([][][]string, t.numRows)
-	t.lineHeights = make([]int, t.numRows)
+	t.resetData(len(v.Data))
+	return t.appendInputRows(v.Data)
+}
+
+func (t *Table) setStructData(rv reflect.Value) error {
+	t.resetData(t.numRows)
+	return t.appendStructRows(rv)
+}
+
+func (t *Table) resetData(numRows int) {
+	n := max(t.numColumns, t.numColumnsFirstRow)
+	t.data = slices.Grow(t.data[:0], numRows)
+	t.unstyled = t.unstyled[:0]
+	t.lineHeights = slices.Grow(t.lineHeights[:0], numRows)
+	t.prevRow = slices.Grow(t.prevRow[:0], n)[:n]
+	clear(t.prevRow)
+}
+
+func (t *Table) appendInputRow(r []any) error {
+	row := t.newRawRow()
+	ok, err := t.formatInputRow(&row, r)
+	if err != nil || !ok {
+		return err
+	}
+	t.pushRow(row)
+	return nil
+}
+
+func (t *Table) appendInputRows(rows [][]any) error {
+	return t.formatRows(len(rows), func(i int, row *rawRow) (bool, error) {
+		return t.formatInputRow(row, rows[i])
+	})
+}
+
+func (t *Table) formatInputRow(row *rawRow, r []any) (bool, error) {
+	if len(r) != t.numColumnsFirstRow {
+		return false, fmt.Errorf("cannot load input: number of columns must be the same for all rows")
+	}
+	if !t.filter(t.inputHeader, r) {
+		return false, nil
+	}
 	n := t.numColumns
-	t.prevRow = make([]string, n)
-	for i, r := range v.Data {
-		if i > 0 && len(r) != t.numColumnsFirstRow {
-			return fmt.Errorf("cannot load input: number of columns must be the same for all rows")
-		}
-		row := make([][]string, n)
-		t.isMerge = true
-		t.lineHeights[i] = 1
-		k := 0
-		for j, field := range r {
-			if slices.Contains(t.ignoredFields, j) {
-				continue
-			}
-			if k >= n {
-				return fmt.Errorf("cannot load input: unexpected column number of non-ignored fields")
-			}
-			s, err := t.formatField(reflect.ValueOf(field))
-			if err != nil {
-				return err
-			}
-			s = t.merge(s, j)
-			elems := splitLines(s)
-			row[k] = elems
-			t.updateColWidths(elems, k)
-			t.getLineHeight(elems, i)
-			k++
+	k := 0
+	for j, field := range r {
+		if slices.Contains(t.ignoredFields, j) {
+			continue
+		}
+		if k >= n {
+			return false, fmt.Errorf("cannot load input: unexpected column number of non-ignored fields")
+		}
+		s, err := t.formatValue(reflect.ValueOf(field), k)
+		if err != nil {
+			return false, err
 		}
-		t.data[i] = row
+		row.set(k, s, field)
+		k++
 	}
-	return nil
+	return true, nil
 }
 
-func (t *Table) setStructData(rv reflect.Value) error {
-	t.data = make([][][]string, t.numRows)
-	t.lineHeights = make([]int, t.numRows)
-	t.prevRow = make([]string, t.numColumns)
-	for i := 0; i < t.numRows; i++ {
+func (t *Table) appendStructRows(rv reflect.Value) error {
+	if t.isRower {
+		return t.formatRows(rv.Len(), func(i int, row *rawRow) (bool, error) {
+			e := rv.Index(i)
+			if e.Kind() == reflect.Pointer {
+				e = e.Elem()
+			}
+			return t.formatRowerRow(row, e)
+		})
+	}
+	var (
+		names   []string
+		indices []int
+	)
+	if len(t.filters) > 0 {
+		names, indices = exportedFields(rv.Type().Elem())
+	}
+	return t.formatRows(rv.Len(), func(i int, row *rawRow) (bool, error) {
 		e := rv.Index(i)
 		if e.Kind() == reflect.Pointer {
 			e = e.Elem()
 		}
-		row := make([][]string, t.numColumns)
-		t.isMerge = true
-		t.lineHeights[i] = 1
-		for j, h := range t.header {
-			field := e.FieldByName(h)
-			if !field.IsValid() {
-				return fmt.Errorf("cannot load input: invalid field detected: %s", h)
+		if len(t.filters) > 0 {
+			values := make([]any, len(indices))
+			for k, x := range indices {
+				values[k] = e.Field(x).Interface()
 			}
-			s, err := t.formatField(field)
-			if err != nil {
-				return err
+			if !t.filter(names, values) {
+				return false, nil
 			}
-			s = t.merge(s, j)
-			elems := splitLines(s)
-			row[j] = elems
-			t.updateColWidths(elems, j)
-			t.getLineHeight(elems, i)
 		}
-		t.data[i] = row
+		err := t.formatStructRow(row, e)
+		return err == nil, err
+	})
+}
+
+func (t *Table) formatStructRow(row *rawRow, e reflect.Value) error {
+	for j, h := range t.fieldNames {
+		field := e.FieldByName(h)
+		if !field.IsValid() {
+			return fmt.Errorf("cannot load input: invalid field detected: %s", h)
+		}
+		s, err := t.formatValue(field, j)
+		if err != nil {
+			return err
+		}
+		var v any
+		if row.values != nil {
+			v = field.Interface()
+		}
+		row.set(j, s, v)
 	}
 	return nil
 }
 
+// pushRow adds a formatted row to the data, or keeps it as a raw row to be sorted at rendering.
+func (t *Table) pushRow(r rawRow) {
+	if t.sortFunc != nil {
+		t.rawRows = append(t.rawRows, r)
+		return
+	}
+	t.addRow(r)
+}
+
+func (t *Table) addRow(r rawRow) {
+	i := len(t.data)
+	row := make([][]string, len(r.cells))
+	var unstyled [][]string
+	if r.values != nil {
+		unstyled = make([][]string, len(r.cells))
+	}
+	t.isMerge = true
+	t.lineHeights = append(t.lineHeights, 1)
+	for k, s := range r.cells {
+		elems, w := r.lines[k], r.widths[k]
+		j := k
+		if k < len(t.inputColumns) {
+			j = t.inputColumns[k]
+		}
+		if m := t.merge(s, j); m != s {
+			s = m
+			elems, w = measure(s)
+		}
+		plain := elems
+		if r.values != nil {
+			if styled := t.styleCell(i, k, r.values[k], s); styled != s {
+				elems, w = measure(styled)
+			}
+			unstyled[k] = plain
+		}
+		row[k] = elems
+		if t.isDecimalAlign {
+			w = max(w, t.measureDecimal(k, elems, plain))
+		}
+		if w > t.colWidths[k] {
+			t.colWidths[k] = w
+		}
+		t.getLineHeight(elems, i)
+	}
+	t.data = append(t.data, row)
+	if unstyled != nil {
+		t.unstyled = append(t.unstyled, unstyled)
+	}
+}
+
+func exportedFields(typ reflect.Type) ([]string, []int) {
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	names := make([]string, 0, typ.NumField())
+	indices := make([]int, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath == "" {
+			names = append(names, field.Name)
+			indices = append(indices, i)
+		}
+	}
+	return names, indices
+}
+
 func (t *Table) merge(s string, i int) string {
 	if slices.Contains(t.mergedFields, i) {
 		if s != t.prevRow[i] {
@@ -240,18 +672,19 @@ func (t *Table) merge(s string, i int) string {
 	return s
 }
 
-func (t *Table) updateColWidths(elems []string, i int) {
+// measure splits s into lines and returns them with the max display width.
+func measure(s string) ([]string, int) {
+	elems := splitLines(s)
+	w := 0
 	for _, elem := range elems {
-		w := runewidth.StringWidth(elem)
-		if w > t.colWidths[i] {
-			t.colWidths[i] = w
-		}
+		w = max(w, stringWidth(elem))
 	}
+	return elems, w
 }
 
 func (t *Table) getLineHeight(elems []string, i int) {
 	switch t.format {
-	case TextFormat, CompressedTextFormat:
+	case TextFormat, CompressedTextFormat, VerticalFormat, PlainFormat:
 		height := len(elems)
 		if height > t.lineHeights[i] {
 			t.lineHeights[i] = height
@@ -260,52 +693,91 @@ func (t *Table) getLineHeight(elems []string, i int) {
 }
 
 func (t *Table) setBorder() {
-	var sep string
-	switch t.format {
-	case MarkdownFormat, BacklogFormat:
-		sep = "|"
-	default:
-		sep = "+"
+	f := t.frame()
+	if f.horizontal == "" {
+		t.border = ""
+		t.tableWidth = 0
+		for _, w := range t.colWidths {
+			t.tableWidth += w + t.marginWidthBothSides + len(f.vertical)
+		}
+		return
 	}
 	var b strings.Builder
 	b.Grow(256)
-	for _, w := range t.colWidths {
-		b.WriteString(sep)
+	for i, w := range t.colWidths {
+		if i > 0 || f.edge {
+			b.WriteString(f.cross)
+		}
 		for i := 0; i < w+t.marginWidthBothSides; i++ {
-			b.WriteByte('-')
+			b.WriteString(f.horizontal)
 		}
 	}
-	b.WriteString(sep)
+	if f.edge {
+		b.WriteString(f.cross)
+	}
 	b.WriteString("\n")
 	t.border = b.String()
 	t.tableWidth = len(t.border)
 }
 
-func (t *Table) formatField(rv reflect.Value) (string, error) {
+func (t *Table) formatValue(rv reflect.Value, col int) (string, error) {
+	if fn, ok := t.columnFormatters[col]; ok {
+		var v any
+		if rv.IsValid() {
+			v = rv.Interface()
+		}
+		return t.sanitize(fn(v)), nil
+	}
+	return t.formatField(rv, col)
+}
+
+func (t *Table) formatField(rv reflect.Value, col int) (string, error) {
+	if !rv.IsValid() || isNil(rv) {
+		return t.placeholder, nil
+	}
+	if rv.Type() == diffChangeType {
+		return t.formatDiffChange(rv.Interface().(DiffChange), col)
+	}
+	if s, ok := t.formatType(rv); ok {
+		return t.sanitize(s), nil
+	}
+	if rv.Kind() == reflect.Interface {
+		return t.formatField(rv.Elem(), col)
+	}
 	if rv.Kind() == reflect.Pointer {
-		if rv.IsNil() {
-			return t.placeholder, nil
+		if s, ok := t.formatType(rv.Elem()); ok {
+			return t.sanitize(s), nil
+		}
+		if s, ok, err := t.convert(rv); ok || err != nil {
+			return t.sanitize(s), err
 		}
 		rv = rv.Elem()
 	}
-	if s := getStringer(rv); s != "" {
-		return t.sanitize(s), nil
+	if s, ok, err := t.convert(rv); ok || err != nil {
+		return t.sanitize(s), err
 	}
 	switch rv.Kind() {
 	case reflect.String:
 		return t.sanitize(rv.String()), nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return strconv.FormatInt(rv.Int(), 10), nil
+		return t.sanitize(t.formatInt(rv.Int(), col)), nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return strconv.FormatUint(rv.Uint(), 10), nil
+		return t.sanitize(t.formatUint(rv.Uint(), col)), nil
 	case reflect.Float32:
-		return strconv.FormatFloat(rv.Float(), 'f', -1, 32), nil
+		return t.sanitize(t.formatFloat(rv.Float(), 32, col)), nil
 	case reflect.Float64:
-		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+		return t.sanitize(t.formatFloat(rv.Float(), 64, col)), nil
+	case reflect.Bool:
+		s := t.formatBool(rv.Bool(), col)
+		if s == t.placeholder {
+			// the placeholder is already formatted for the output format like those of nil values
+			return s, nil
+		}
+		return t.sanitize(s), nil
 	case reflect.Struct:
 		return "", fmt.Errorf("cannot load input: nested fields not supported")
 	case reflect.Slice, reflect.Array:
-		s, err := t.formatSlice(rv)
+		s, err := t.formatSlice(rv, col)
 		if err != nil {
 			return "", err
 		}
@@ -315,7 +787,7 @@ func (t *Table) formatField(rv reflect.Value) (string, error) {
 	}
 }
 
-func (t *Table) formatSlice(rv reflect.Value) (string, error) {
+func (t *Table) formatSlice(rv reflect.Value, col int) (string, error) {
 	length := rv.Len()
 	switch {
 	case length == 0:
@@ -331,14 +803,36 @@ func (t *Table) formatSlice(rv reflect.Value) (string, error) {
 			if i != 0 {
 				b.WriteString(t.wordDelimiter)
 			}
-			if e.Kind() == reflect.Pointer {
-				if e.IsNil() {
-					b.WriteString(t.placeholder)
+			if isNil(e) {
+				b.WriteString(t.placeholder)
+				continue
+			}
+			if s, ok := t.formatType(e); ok {
+				b.WriteString(cmp.Or(s, t.placeholder))
+				continue
+			}
+			if e.Kind() == reflect.Interface || e.Kind() == reflect.Pointer {
+				if s, ok := t.formatType(e.Elem()); ok {
+					b.WriteString(cmp.Or(s, t.placeholder))
+					continue
+				}
+				s, ok, err := t.convert(e)
+				if err != nil {
+					bufPool.Put(b)
+					return "", err
+				}
+				if ok {
+					b.WriteString(s)
 					continue
 				}
 				e = e.Elem()
 			}
-			if s := getStringer(e); s != "" {
+			s, ok, err := t.convert(e)
+			if err != nil {
+				bufPool.Put(b)
+				return "", err
+			}
+			if ok {
 				b.WriteString(s)
 				continue
 			}
@@ -358,29 +852,31 @@ func (t *Table) formatSlice(rv reflect.Value) (string, error) {
 					b.WriteString(v)
 				}
 			case int:
-				b.WriteString(strconv.FormatInt(int64(v), 10))
+				b.WriteString(t.formatInt(int64(v), col))
 			case int8:
-				b.WriteString(strconv.FormatInt(int64(v), 10))
+				b.WriteString(t.formatInt(int64(v), col))
 			case int16:
-				b.WriteString(strconv.FormatInt(int64(v), 10))
+				b.WriteString(t.formatInt(int64(v), col))
 			case int32:
-				b.WriteString(strconv.FormatInt(int64(v), 10))
+				b.WriteString(t.formatInt(int64(v), col))
 			case int64:
-				b.WriteString(strconv.FormatInt(v, 10))
+				b.WriteString(t.formatInt(v, col))
 			case uint:
-				b.WriteString(strconv.FormatUint(uint64(v), 10))
+				b.WriteString(t.formatUint(uint64(v), col))
 			case uint8:
-				b.WriteString(strconv.FormatUint(uint64(v), 10))
+				b.WriteString(t.formatUint(uint64(v), col))
 			case uint16:
-				b.WriteString(strconv.FormatUint(uint64(v), 10))
+				b.WriteString(t.formatUint(uint64(v), col))
 			case uint32:
-				b.WriteString(strconv.FormatUint(uint64(v), 10))
+				b.WriteString(t.formatUint(uint64(v), col))
 			case uint64:
-				b.WriteString(strconv.FormatUint(v, 10))
+				b.WriteString(t.formatUint(v, col))
 			case float32:
-				b.WriteString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+				b.WriteString(t.formatFloat(float64(v), 32, col))
 			case float64:
-				b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+				b.WriteString(t.formatFloat(v, 64, col))
+			case bool:
+				b.WriteString(t.formatBool(v, col))
 			default:
 				fmt.Fprint(b, v)
 			}
@@ -392,11 +888,65 @@ func (t *Table) formatSlice(rv reflect.Value) (string, error) {
 	}
 }
 
-func getStringer(rv reflect.Value) string {
-	if s, ok := rv.Interface().(fmt.Stringer); ok {
-		return s.String()
+// formatDiffChange formats the old and new values of c like other fields and joins them with DiffArrow.
+// If either value has multiple lines, the new value starts on its own line after the arrow.
+func (t *Table) formatDiffChange(c DiffChange, col int) (string, error) {
+	o, err := t.formatField(reflect.ValueOf(c.Old), col)
+	if err != nil {
+		return "", err
+	}
+	n, err := t.formatField(reflect.ValueOf(c.New), col)
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(o, t.newLine) || strings.Contains(n, t.newLine) {
+		return o + t.newLine + strings.TrimLeft(DiffArrow, " ") + n, nil
+	}
+	return o + DiffArrow + n, nil
+}
+
+// isNil reports whether rv is a nil pointer, interface or slice, which is rendered as the placeholder
+// without being passed to formatters.
+func isNil(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+func (t *Table) formatType(rv reflect.Value) (string, bool) {
+	if fn, ok := t.typeFormatters[rv.Type()]; ok {
+		return fn(rv.Interface()), true
+	}
+	return t.formatTime(rv)
+}
+
+func (t *Table) formatBool(v bool, col int) string {
+	f, ok := t.columnBoolFormats[col]
+	if !ok {
+		f = t.boolFormat
+	}
+	switch f {
+	case YesNoBoolFormat:
+		if v {
+			return "yes"
+		}
+		return "no"
+	case CheckBoolFormat:
+		if v {
+			return checkMark
+		}
+		return crossMark
+	case CheckOnlyBoolFormat:
+		if v {
+			return checkMark
+		}
+		return t.placeholder
+	default:
+		return strconv.FormatBool(v)
 	}
-	return ""
 }
 
 func splitLines(s string) []string {
@@ -440,7 +990,7 @@ func (t *Table) sanitize(s string) string {
 	if t.format == MarkdownFormat && strings.HasPrefix(s, "*") {
 		s = "\\" + s
 	}
-	if t.format == TextFormat {
+	if t.format == TextFormat || t.format == VerticalFormat || t.format == PlainFormat {
 		return s
 	}
 	if !strings.Contains(s, "\n") {
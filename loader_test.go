@@ -2,12 +2,19 @@ package mintab
 
 import (
 	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
 	"net"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 	"time"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestTable_Load(t *testing.T) {
@@ -234,6 +241,602 @@ func TestTable_Load(t *testing.T) {
 	}
 }
 
+func TestTable_Reset(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := New(buf, WithFormat(CompressedTextFormat), WithMergeFields([]int{0}))
+	if err := table.Load(Input{
+		Header: []string{"LongName", "Value"},
+		Data:   [][]any{{"a", "very long value"}, {"b", 1}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.Load(Input{
+		Header: []string{"Name", "Value"},
+		Data:   [][]any{{"b", 1}, {"b", 2}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := `+------+-------+
+| Name | Value |
++------+-------+
+| b    |     1 |
+|      |     2 |
++------+-------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+	table.Reset()
+	buf.Reset()
+	table.Render()
+	if buf.Len() != 0 {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", buf.String(), "")
+	}
+}
+
+func TestTable_AppendRow(t *testing.T) {
+	type args struct {
+		v    any
+		rows [][]any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "header_only",
+			args: args{
+				v:    Input{Header: []string{"Name", "Count"}},
+				rows: [][]any{{"a", 1}, {"a", 22}, {"longer", 3}},
+			},
+			want: `+--------+-------+
+| Name   | Count |
++--------+-------+
+| a      |     1 |
+|        |    22 |
++--------+-------+
+| longer |     3 |
++--------+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "after_data",
+			args: args{
+				v:    Input{Header: []string{"Name", "Count"}, Data: [][]any{{"a", 1}}},
+				rows: [][]any{{"b", 1000}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+| b    |  1000 |
++------+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "invalid_columns",
+			args: args{
+				v:    Input{Header: []string{"Name", "Count"}},
+				rows: [][]any{{"a", 1}, {"b"}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`,
+			wantErr: true,
+		},
+		{
+			name: "struct",
+			args: args{
+				v:    []struct{ Name string }{{Name: "a"}},
+				rows: [][]any{{"b"}},
+			},
+			want: `+------+
+| Name |
++------+
+| a    |
++------+
+`,
+			wantErr: true,
+		},
+		{
+			name: "not_loaded",
+			args: args{
+				v:    nil,
+				rows: [][]any{{"a"}},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, WithFormat(CompressedTextFormat), WithMergeFields([]int{0}))
+			if err := table.Load(tt.args.v); err != nil {
+				t.Fatal(err)
+			}
+			var err error
+			for _, row := range tt.args.rows {
+				if err = table.AppendRow(row); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_AppendRows(t *testing.T) {
+	type row struct {
+		Name  string
+		Count int
+	}
+	type other struct {
+		Name string
+	}
+	type args struct {
+		v    any
+		rows any
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "struct",
+			args: args{
+				v:    []row{{Name: "a", Count: 1}},
+				rows: []*row{{Name: "b", Count: 22}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
+| b    |    22 |
++------+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "single_struct",
+			args: args{
+				v:    []row{{Name: "a", Count: 1}},
+				rows: row{Name: "abcdef", Count: 2},
+			},
+			want: `+--------+-------+
+| Name   | Count |
++--------+-------+
+| a      |     1 |
+| abcdef |     2 |
++--------+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "input",
+			args: args{
+				v:    Input{Header: []string{"Name", "Count"}, Data: [][]any{{"a", 1}}},
+				rows: &Input{Data: [][]any{{"b", 2}, {"c", 3}}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
+| b    |     2 |
+| c    |     3 |
++------+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "not_loaded",
+			args: args{
+				v:    nil,
+				rows: []row{{Name: "a", Count: 1}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "different_type",
+			args: args{
+				v:    []row{{Name: "a", Count: 1}},
+				rows: []other{{Name: "b"}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`,
+			wantErr: true,
+		},
+		{
+			name: "different_header",
+			args: args{
+				v:    Input{Header: []string{"Name", "Count"}, Data: [][]any{{"a", 1}}},
+				rows: Input{Header: []string{"Name", "Total"}, Data: [][]any{{"b", 2}}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`,
+			wantErr: true,
+		},
+		{
+			name: "input_to_struct",
+			args: args{
+				v:    []row{{Name: "a", Count: 1}},
+				rows: Input{Data: [][]any{{"b", 2}}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`,
+			wantErr: true,
+		},
+		{
+			name: "struct_to_input",
+			args: args{
+				v:    Input{Header: []string{"Name", "Count"}, Data: [][]any{{"a", 1}}},
+				rows: []row{{Name: "b", Count: 2}},
+			},
+			want: `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, WithFormat(CompressedTextFormat))
+			if err := table.Load(tt.args.v); err != nil {
+				t.Fatal(err)
+			}
+			err := table.AppendRows(tt.args.rows)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_AppendRow_concurrent(t *testing.T) {
+	regions := []string{"ap-northeast-1", "eu-west-1", "us-east-1", "us-west-2"}
+	buf := &bytes.Buffer{}
+	table := New(buf,
+		WithFormat(CompressedTextFormat),
+		WithMergeFields([]int{0}),
+		WithSortFunc(func(a, b []string) int {
+			if c := strings.Compare(a[0], b[0]); c != 0 {
+				return c
+			}
+			return strings.Compare(a[1], b[1])
+		}),
+	)
+	if err := table.Load(Input{Header: []string{"Region", "Instance"}}); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for _, region := range slices.Backward(regions) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 3 {
+				if err := table.AppendRow([]any{region, fmt.Sprintf("i-%d", 3-i)}); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	table.Render()
+	want := `+----------------+----------+
+| Region         | Instance |
++----------------+----------+
+| ap-northeast-1 | i-1      |
+|                | i-2      |
+|                | i-3      |
++----------------+----------+
+| eu-west-1      | i-1      |
+|                | i-2      |
+|                | i-3      |
++----------------+----------+
+| us-east-1      | i-1      |
+|                | i-2      |
+|                | i-3      |
++----------------+----------+
+| us-west-2      | i-1      |
+|                | i-2      |
+|                | i-3      |
++----------------+----------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestTable_sortRows(t *testing.T) {
+	type row struct {
+		Name  string
+		Count int
+	}
+	buf := &bytes.Buffer{}
+	table := New(buf,
+		WithFormat(CompressedTextFormat),
+		WithSortFunc(func(a, b []string) int {
+			return strings.Compare(a[0], b[0])
+		}),
+		WithCellStyle(func(row, col int, value any) Style {
+			if row == 0 && col == 0 {
+				return Style{Marker: "*"}
+			}
+			return Style{}
+		}),
+	)
+	if err := table.Load([]row{{Name: "c", Count: 3}, {Name: "a", Count: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.AppendRows([]row{{Name: "b", Count: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := `+------+-------+
+| Name | Count |
++------+-------+
+| *a   |     1 |
+| b    |     2 |
+| c    |     3 |
++------+-------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+	if err := table.AppendRows(row{Name: "0", Count: 100}); err != nil {
+		t.Fatal(err)
+	}
+	want = `+------+-------+
+| Name | Count |
++------+-------+
+|   *0 |   100 |
+| a    |     1 |
+| b    |     2 |
+| c    |     3 |
++------+-------+
+`
+	if diff := cmp.Diff(table.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestTable_columnFormatter(t *testing.T) {
+	type object struct {
+		Key      string
+		Size     int64
+		Modified time.Time
+		Accessed time.Time
+	}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	objects := []object{
+		{Key: "a.txt", Size: 512, Modified: now.Add(-3 * time.Hour), Accessed: now.Add(-3 * time.Hour)},
+		{Key: "b.bin", Size: 3 << 20, Modified: now.Add(-48 * time.Hour), Accessed: now.Add(-30 * time.Minute)},
+	}
+	size := func(v any) string {
+		n := v.(int64)
+		if n < 1<<10 {
+			return fmt.Sprintf("%d", n)
+		}
+		return fmt.Sprintf("%dMi", n>>20)
+	}
+	rfc3339 := func(v any) string {
+		return v.(time.Time).Format(time.RFC3339)
+	}
+	ago := func(v any) string {
+		return now.Sub(v.(time.Time)).String() + " ago"
+	}
+	empty := func(v any) string {
+		return ""
+	}
+	in := Input{Header: []string{"Key", "Size", "Modified", "Accessed"}}
+	for _, o := range objects {
+		in.Data = append(in.Data, []any{o.Key, o.Size, o.Modified, o.Accessed})
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		v    any
+		want string
+	}{
+		{
+			name: "struct",
+			opts: []Option{
+				WithColumnFormatter(1, size),
+				WithColumnFormatter(2, rfc3339),
+				WithColumnFormatter(3, ago),
+			},
+			v: objects,
+			want: `+-------+------+----------------------+------------+
+| Key   | Size | Modified             | Accessed   |
++-------+------+----------------------+------------+
+| a.txt |  512 | 2026-10-18T09:00:00Z | 3h0m0s ago |
++-------+------+----------------------+------------+
+| b.bin | 3Mi  | 2026-10-16T12:00:00Z | 30m0s ago  |
++-------+------+----------------------+------------+
+`,
+		},
+		{
+			name: "input_ignore",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithIgnoreFields([]int{1}),
+				WithColumnFormatter(1, rfc3339),
+				WithColumnFormatter(2, empty),
+			},
+			v: in,
+			want: `+-------+----------------------+----------+
+| Key   | Modified             | Accessed |
++-------+----------------------+----------+
+| a.txt | 2026-10-18T09:00:00Z | -        |
+| b.bin | 2026-10-16T12:00:00Z | -        |
++-------+----------------------+----------+
+`,
+		},
+		{
+			name: "nil",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithColumnFormatter(1, func(v any) string {
+					return fmt.Sprint(v == nil)
+				}),
+			},
+			v: Input{Header: []string{"Key", "Value"}, Data: [][]any{{"a", nil}}},
+			want: `+-----+-------+
+| Key | Value |
++-----+-------+
+| a   | true  |
++-----+-------+
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(tt.v); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_typeFormatter(t *testing.T) {
+	type uuid [16]byte
+	type version struct {
+		Major int
+	}
+	type session struct {
+		ID      uuid
+		Parents []uuid
+		Addr    net.IP
+		Start   *time.Time
+		Timeout time.Duration
+		Tokens  *big.Int
+		Version *version
+	}
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	id := uuid{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+	parent := uuid{0xff}
+	tokens, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	sessions := []session{
+		{ID: id, Parents: []uuid{parent, id}, Addr: net.IPv4(10, 0, 0, 1), Start: &start, Timeout: 90 * time.Second, Tokens: tokens, Version: &version{Major: 2}},
+		{ID: parent},
+	}
+	opts := []Option{
+		WithFormat(CompressedTextFormat),
+		WithTypeFormatter(func(v uuid) string {
+			return hex.EncodeToString(v[:4])
+		}),
+		WithTypeFormatter(func(v net.IP) string {
+			return v.String()
+		}),
+		WithTypeFormatter(func(v time.Time) string {
+			return v.Format(time.DateOnly)
+		}),
+		WithTypeFormatter(func(v time.Duration) string {
+			return fmt.Sprintf("%.0fs", v.Seconds())
+		}),
+		WithTypeFormatter(func(v *big.Int) string {
+			return v.Text(16)
+		}),
+		WithTypeFormatter(func(v *version) string {
+			return fmt.Sprintf("v%d", v.Major)
+		}),
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "basic",
+			opts: opts,
+			want: `+----------+----------+----------+------------+---------+---------------------------+---------+
+| ID       | Parents  | Addr     | Start      | Timeout | Tokens                    | Version |
++----------+----------+----------+------------+---------+---------------------------+---------+
+| 12345678 | ff000000 | 10.0.0.1 | 2026-10-18 | 90s     | 18ee90ff6c373e0ee4e3f0ad2 | v2      |
+|          | 12345678 |          |            |         |                           |         |
+| ff000000 | -        | -        | -          | 0s      | -                         | -       |
++----------+----------+----------+------------+---------+---------------------------+---------+
+`,
+		},
+		{
+			name: "column_precedence",
+			opts: append(slices.Clone(opts), WithColumnFormatter(0, func(v any) string {
+				return "id"
+			})),
+			want: `+----+----------+----------+------------+---------+---------------------------+---------+
+| ID | Parents  | Addr     | Start      | Timeout | Tokens                    | Version |
++----+----------+----------+------------+---------+---------------------------+---------+
+| id | ff000000 | 10.0.0.1 | 2026-10-18 | 90s     | 18ee90ff6c373e0ee4e3f0ad2 | v2      |
+|    | 12345678 |          |            |         |                           |         |
+| id | -        | -        | -          | 0s      | -                         | -       |
++----+----------+----------+------------+---------+---------------------------+---------+
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(sessions); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestTable_setFormat(t *testing.T) {
 	type fields struct {
 		format Format
@@ -1370,7 +1973,7 @@ func TestTable_formatField(t *testing.T) {
 				isEscape:        tt.fields.isEscape,
 				isBytesToString: tt.fields.isBytesToString,
 			}
-			got, err := tr.formatField(v)
+			got, err := tr.formatField(v, 0)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
 				return
@@ -1521,3 +2124,159 @@ func TestTable_escape(t *testing.T) {
 		})
 	}
 }
+
+func TestTable_boolFormat(t *testing.T) {
+	type flag struct {
+		Name    string
+		Enabled bool
+		Beta    *bool
+		Regions []bool
+	}
+	yes := true
+	flags := []flag{
+		{Name: "a", Enabled: true, Beta: &yes, Regions: []bool{true, false}},
+		{Name: "b", Enabled: false},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "default",
+			opts: []Option{WithFormat(CompressedTextFormat)},
+			want: `+------+---------+------+---------+
+| Name | Enabled | Beta | Regions |
++------+---------+------+---------+
+| a    | true    | true | true    |
+|      |         |      | false   |
+| b    | false   | -    | -       |
++------+---------+------+---------+
+`,
+		},
+		{
+			name: "yesno",
+			opts: []Option{WithFormat(CompressedTextFormat), WithBoolFormat(YesNoBoolFormat)},
+			want: `+------+---------+------+---------+
+| Name | Enabled | Beta | Regions |
++------+---------+------+---------+
+| a    | yes     | yes  | yes     |
+|      |         |      | no      |
+| b    | no      | -    | -       |
++------+---------+------+---------+
+`,
+		},
+		{
+			name: "column",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithBoolFormat(CheckBoolFormat),
+				WithColumnBoolFormat(1, CheckOnlyBoolFormat),
+				WithColumnBoolFormat(3, TrueFalseBoolFormat),
+			},
+			want: `+------+---------+------+---------+
+| Name | Enabled | Beta | Regions |
++------+---------+------+---------+
+| a    | ✓       | ✓    | true    |
+|      |         |      | false   |
+| b    | -       | -    | -       |
++------+---------+------+---------+
+`,
+		},
+		{
+			name: "check",
+			opts: []Option{WithFormat(PlainFormat), WithBoolFormat(CheckBoolFormat), WithIgnoreFields([]int{2})},
+			want: `Name   Enabled   Regions
+a      ✓         ✓
+                 ✗
+b      ✗         -
+`,
+		},
+		{
+			name: "check_only_markdown_escape",
+			opts: []Option{
+				WithFormat(MarkdownFormat),
+				WithEscape(true),
+				WithBoolFormat(CheckOnlyBoolFormat),
+				WithIgnoreFields([]int{2, 3}),
+			},
+			want: `| Name | Enabled |
+|------|---------|
+| a    | ✓       |
+| b    | \-      |
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(flags); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_keyValueInput(t *testing.T) {
+	type node struct {
+		Name   string
+		Parent *node
+	}
+	root := &node{Name: "root"}
+	root.Parent = root
+	shared := &node{Name: "shared"}
+	pair := struct {
+		Left  *node
+		Right *node
+	}{Left: shared, Right: shared}
+	loop := map[string]any{"name": "loop"}
+	loop["self"] = loop
+	tests := []struct {
+		name string
+		v    any
+		want [][]any
+	}{
+		{
+			name: "pointer_cycle",
+			v:    root,
+			want: [][]any{
+				{"Name", "root"},
+				{"Parent", fmt.Sprintf("%p", root)},
+			},
+		},
+		{
+			name: "shared_pointer",
+			v:    pair,
+			want: [][]any{
+				{"Left.Name", "shared"},
+				{"Left.Parent", nil},
+				{"Right.Name", "shared"},
+				{"Right.Parent", nil},
+			},
+		},
+		{
+			name: "map_cycle",
+			v:    loop,
+			want: [][]any{
+				{"name", "loop"},
+				{"self", fmt.Sprintf("%p", loop)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := keyValueInput(tt.v)
+			if !ok {
+				t.Fatal("not flattened")
+			}
+			if diff := cmp.Diff(got.Data, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
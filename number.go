@@ -0,0 +1,270 @@
+package mintab
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	siPrefixes  = []string{"", "k", "M", "G", "T", "P", "E"}
+	iecPrefixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+)
+
+// A NumberFormat represents the formatting of numeric field values.
+// The zero value formats floats in the shortest representation and leaves integers unchanged.
+type NumberFormat struct {
+	Precision      int    // Number of fractional digits of floats and scaled integers, or zero or negative for the shortest representation
+	FixedPrecision bool   // Whether a zero Precision formats floats without fractional digits instead of the shortest representation
+	Thousands      string // Separator between groups of three integer digits
+	DecimalMark    string // Mark between the integer and fractional parts, "." if empty
+	Percent        bool   // Whether values are multiplied by 100 and followed by "%"
+	Unit           Unit   // Prefixes by which values are scaled
+	Suffix         string // Text appended to the value, such as "B" for bytes
+}
+
+func (f *NumberFormat) formatInt(v int64) string {
+	if f.Percent || f.Unit != NoUnit {
+		return f.formatFloat(float64(v), 64)
+	}
+	return f.decorate(strconv.FormatInt(v, 10), "")
+}
+
+func (f *NumberFormat) formatUint(v uint64) string {
+	if f.Percent || f.Unit != NoUnit {
+		return f.formatFloat(float64(v), 64)
+	}
+	return f.decorate(strconv.FormatUint(v, 10), "")
+}
+
+func (f *NumberFormat) formatFloat(v float64, bitSize int) string {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return strconv.FormatFloat(v, 'f', -1, bitSize)
+	}
+	if f.Percent {
+		v *= 100
+	}
+	prec := f.precision()
+	v, prefix := f.Unit.scale(v, prec)
+	return f.decorate(strconv.FormatFloat(v, 'f', prec, bitSize), prefix)
+}
+
+// precision returns the number of fractional digits passed to strconv, where -1 means the shortest representation.
+func (f *NumberFormat) precision() int {
+	if f.Precision < 0 || f.Precision == 0 && !f.FixedPrecision {
+		return -1
+	}
+	return f.Precision
+}
+
+// decorate applies the separators, the prefix and the suffixes to s, a number formatted by strconv.
+func (f *NumberFormat) decorate(s, prefix string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		s = s[1:]
+		if strings.Trim(s, "0.") != "" {
+			sign = "-"
+		}
+	}
+	integer, fraction, hasFraction := strings.Cut(s, ".")
+	var b strings.Builder
+	b.Grow(len(s) + len(s)/3*len(f.Thousands) + len(f.DecimalMark) + len(prefix) + len(f.Suffix) + 2)
+	b.WriteString(sign)
+	if f.Thousands == "" {
+		b.WriteString(integer)
+	} else {
+		for i := range len(integer) {
+			if i > 0 && (len(integer)-i)%3 == 0 {
+				b.WriteString(f.Thousands)
+			}
+			b.WriteByte(integer[i])
+		}
+	}
+	if hasFraction {
+		if f.DecimalMark == "" {
+			b.WriteByte('.')
+		} else {
+			b.WriteString(f.DecimalMark)
+		}
+		b.WriteString(fraction)
+	}
+	b.WriteString(prefix)
+	if f.Percent {
+		b.WriteByte('%')
+	}
+	b.WriteString(f.Suffix)
+	return b.String()
+}
+
+// isNum reports whether s is a number formatted with f, ignoring the unit prefix and suffixes.
+func (f *NumberFormat) isNum(s string) bool {
+	s = strings.TrimSuffix(s, f.Suffix)
+	if f.Percent {
+		s = strings.TrimSuffix(s, "%")
+	}
+	if _, prefixes := f.Unit.prefixes(); prefixes != nil {
+		for _, prefix := range prefixes[1:] {
+			if strings.HasSuffix(s, prefix) {
+				s = strings.TrimSuffix(s, prefix)
+				break
+			}
+		}
+	}
+	mark, thousands := f.DecimalMark, f.Thousands
+	if mark == "" {
+		mark = "."
+	}
+	if thousands == "" && mark != "," {
+		thousands = ","
+	}
+	return isNumber(s, thousands, mark)
+}
+
+func (u Unit) prefixes() (float64, []string) {
+	switch u {
+	case SIUnit:
+		return 1000, siPrefixes
+	case IECUnit:
+		return 1024, iecPrefixes
+	default:
+		return 0, nil
+	}
+}
+
+// scale divides v by the base of u until it is smaller than the base, taking rounding to prec digits into account.
+func (u Unit) scale(v float64, prec int) (float64, string) {
+	base, prefixes := u.prefixes()
+	if prefixes == nil {
+		return v, ""
+	}
+	i := 0
+	for math.Abs(v) >= base && i < len(prefixes)-1 {
+		v /= base
+		i++
+	}
+	if prec >= 0 && i < len(prefixes)-1 {
+		if r, err := strconv.ParseFloat(strconv.FormatFloat(v, 'f', prec, 64), 64); err == nil && math.Abs(r) >= base {
+			v /= base
+			i++
+		}
+	}
+	return v, prefixes[i]
+}
+
+func (t *Table) numberFormat(col int) *NumberFormat {
+	if f, ok := t.columnNumberFormats[col]; ok {
+		return &f
+	}
+	return t.defaultNumberFormat
+}
+
+func (t *Table) formatInt(v int64, col int) string {
+	if f := t.numberFormat(col); f != nil {
+		return f.formatInt(v)
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+func (t *Table) formatUint(v uint64, col int) string {
+	if f := t.numberFormat(col); f != nil {
+		return f.formatUint(v)
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+func (t *Table) formatFloat(v float64, bitSize, col int) string {
+	if f := t.numberFormat(col); f != nil {
+		return f.formatFloat(v, bitSize)
+	}
+	return strconv.FormatFloat(v, 'f', -1, bitSize)
+}
+
+// measureDecimal updates the widths of the integer and fractional parts of numbers in the column at index col
+// with the lines of a field, and returns the width of the column required to align them.
+// Numbers are detected in plain, the lines before the cell style is applied to elems.
+func (t *Table) measureDecimal(col int, elems, plain []string) int {
+	if t.format == VerticalFormat {
+		return 0
+	}
+	for len(t.fracWidths) <= col {
+		t.intWidths = append(t.intWidths, 0)
+		t.fracWidths = append(t.fracWidths, 0)
+	}
+	for j, e := range elems {
+		p := e
+		if j < len(plain) {
+			p = plain[j]
+		}
+		if !t.isNumeric(col, p) {
+			continue
+		}
+		integer, frac := t.decimalParts(col, e, p)
+		t.intWidths[col] = max(t.intWidths[col], integer)
+		t.fracWidths[col] = max(t.fracWidths[col], frac)
+	}
+	return t.intWidths[col] + t.fracWidths[col]
+}
+
+// decimalPad returns the number of spaces padded after the number s in the column at index col to align the decimal marks,
+// where plain is s before the cell style is applied.
+func (t *Table) decimalPad(col int, s, plain string) int {
+	if col >= len(t.fracWidths) || t.fracWidths[col] == 0 {
+		return 0
+	}
+	_, frac := t.decimalParts(col, s, plain)
+	return t.fracWidths[col] - frac
+}
+
+// decimalParts returns the widths of the integer and fractional parts of the number s in the column at index col.
+// The markup of the cell style around plain, the number before it is styled, is counted in the adjacent part.
+// Changes of Diff are aligned by their new values.
+func (t *Table) decimalParts(col int, s, plain string) (int, int) {
+	s, plain = stripANSI(s), stripANSI(plain)
+	number := plain
+	if i := strings.LastIndex(number, DiffArrow); i >= 0 {
+		number = number[i+len(DiffArrow):]
+	}
+	frac := fracWidth(number, t.thousands(col))
+	if s != plain {
+		if i := strings.Index(s, plain); i >= 0 {
+			frac += stringWidth(s[i+len(plain):])
+		}
+	}
+	return stringWidth(s) - frac, frac
+}
+
+func (t *Table) thousands(col int) string {
+	f := t.numberFormat(col)
+	if f == nil {
+		return ","
+	}
+	if f.Thousands == "" && f.DecimalMark != "," {
+		return ","
+	}
+	return f.Thousands
+}
+
+// fracWidth returns the width of s after its integer part, which consists of the sign, digits and thousands separators.
+// The decimal mark, the fractional digits, the unit prefix, the percent sign and the suffix are all in the fractional part,
+// so that decorated numbers are aligned by their integer parts. Infinities and NaN have no fractional part.
+func fracWidth(s, thousands string) int {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	hasDigits := false
+	for i < len(s) {
+		if s[i] >= '0' && s[i] <= '9' {
+			hasDigits = true
+			i++
+		} else if hasDigits && thousands != "" && strings.HasPrefix(s[i:], thousands) {
+			i += len(thousands)
+		} else {
+			break
+		}
+	}
+	if !hasDigits {
+		return 0
+	}
+	return stringWidth(s[i:])
+}
@@ -0,0 +1,428 @@
+package mintab
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format NumberFormat
+		v      any
+		want   string
+	}{
+		{
+			name:   "zero_int",
+			format: NumberFormat{},
+			v:      int64(1234567),
+			want:   "1234567",
+		},
+		{
+			name:   "zero_float",
+			format: NumberFormat{},
+			v:      12.6,
+			want:   "12.6",
+		},
+		{
+			name:   "zero_float_thousands",
+			format: NumberFormat{Thousands: ","},
+			v:      1234.3,
+			want:   "1,234.3",
+		},
+		{
+			name:   "fixed_zero",
+			format: NumberFormat{FixedPrecision: true},
+			v:      12.6,
+			want:   "13",
+		},
+		{
+			name:   "shortest",
+			format: NumberFormat{Precision: -1},
+			v:      12.25,
+			want:   "12.25",
+		},
+		{
+			name:   "precision",
+			format: NumberFormat{Precision: 2},
+			v:      12.300000000000001,
+			want:   "12.30",
+		},
+		{
+			name:   "thousands_int",
+			format: NumberFormat{Thousands: ","},
+			v:      int64(-1234567),
+			want:   "-1,234,567",
+		},
+		{
+			name:   "thousands_uint",
+			format: NumberFormat{Thousands: ","},
+			v:      uint64(123456),
+			want:   "123,456",
+		},
+		{
+			name:   "thousands_short",
+			format: NumberFormat{Thousands: ","},
+			v:      int64(123),
+			want:   "123",
+		},
+		{
+			name:   "decimal_mark",
+			format: NumberFormat{Precision: 2, Thousands: ".", DecimalMark: ","},
+			v:      1234567.891,
+			want:   "1.234.567,89",
+		},
+		{
+			name:   "negative_zero",
+			format: NumberFormat{Precision: 1},
+			v:      -0.01,
+			want:   "0.0",
+		},
+		{
+			name:   "percent",
+			format: NumberFormat{Precision: 1, Percent: true},
+			v:      0.1234,
+			want:   "12.3%",
+		},
+		{
+			name:   "percent_int",
+			format: NumberFormat{Percent: true},
+			v:      int64(1),
+			want:   "100%",
+		},
+		{
+			name:   "si",
+			format: NumberFormat{Precision: 1, Unit: SIUnit},
+			v:      int64(1500000),
+			want:   "1.5M",
+		},
+		{
+			name:   "si_small",
+			format: NumberFormat{Precision: 1, Unit: SIUnit},
+			v:      999.0,
+			want:   "999.0",
+		},
+		{
+			name:   "si_rounding",
+			format: NumberFormat{Precision: 1, Unit: SIUnit},
+			v:      999960.0,
+			want:   "1.0M",
+		},
+		{
+			name:   "si_negative",
+			format: NumberFormat{Precision: 2, Unit: SIUnit},
+			v:      int64(-2500),
+			want:   "-2.50k",
+		},
+		{
+			name:   "iec_suffix",
+			format: NumberFormat{Precision: 1, Unit: IECUnit, Suffix: "B"},
+			v:      uint64(1536),
+			want:   "1.5KiB",
+		},
+		{
+			name:   "iec_largest",
+			format: NumberFormat{Unit: IECUnit},
+			v:      uint64(math.MaxUint64),
+			want:   "16Ei",
+		},
+		{
+			name:   "suffix",
+			format: NumberFormat{Thousands: ",", Suffix: " ms"},
+			v:      int64(12000),
+			want:   "12,000 ms",
+		},
+		{
+			name:   "inf",
+			format: NumberFormat{Precision: 2, Suffix: "B"},
+			v:      math.Inf(1),
+			want:   "+Inf",
+		},
+		{
+			name:   "nan",
+			format: NumberFormat{Precision: 2},
+			v:      math.NaN(),
+			want:   "NaN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			switch v := tt.v.(type) {
+			case int64:
+				got = tt.format.formatInt(v)
+			case uint64:
+				got = tt.format.formatUint(v)
+			case float64:
+				got = tt.format.formatFloat(v, 64)
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_numberFormat(t *testing.T) {
+	type result struct {
+		Name  string
+		Count int
+		Cost  float64
+		Ratio float32
+		Sizes []uint64
+	}
+	cost := 0.1
+	results := []result{
+		{Name: "a", Count: 1234567, Cost: cost + 0.2, Ratio: 0.25, Sizes: []uint64{512, 1536}},
+		{Name: "b", Count: 89, Cost: 1000, Ratio: 1, Sizes: []uint64{3 << 20}},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "default",
+			opts: []Option{WithFormat(CompressedTextFormat)},
+			want: `+------+---------+---------------------+-------+---------+
+| Name | Count   | Cost                | Ratio | Sizes   |
++------+---------+---------------------+-------+---------+
+| a    | 1234567 | 0.30000000000000004 |  0.25 |     512 |
+|      |         |                     |       |    1536 |
+| b    |      89 |                1000 |     1 | 3145728 |
++------+---------+---------------------+-------+---------+
+`,
+		},
+		{
+			name: "number_format",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithNumberFormat(NumberFormat{Precision: 2, Thousands: ","}),
+				WithColumnNumberFormat(3, NumberFormat{Percent: true}),
+				WithColumnNumberFormat(4, NumberFormat{Precision: 1, Unit: IECUnit, Suffix: "B"}),
+			},
+			want: `+------+-----------+----------+-------+--------+
+| Name | Count     | Cost     | Ratio | Sizes  |
++------+-----------+----------+-------+--------+
+| a    | 1,234,567 |     0.30 |   25% | 512.0B |
+|      |           |          |       | 1.5KiB |
+| b    |        89 | 1,000.00 |  100% | 3.0MiB |
++------+-----------+----------+-------+--------+
+`,
+		},
+		{
+			name: "column_formatter",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithNumberFormat(NumberFormat{Precision: 2, Thousands: ","}),
+				WithColumnFormatter(0, func(v any) string { return "n/a" }),
+				WithIgnoreFields([]int{0, 3, 4}),
+			},
+			want: `+-------+----------+
+| Count | Cost     |
++-------+----------+
+| n/a   |     0.30 |
+| n/a   | 1,000.00 |
++-------+----------+
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(results); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_decimalAlign(t *testing.T) {
+	in := Input{
+		Header: []string{"Item", "Price", "Qty"},
+		Data: [][]any{
+			{"apple", 1.5, []float64{1, 0.25}},
+			{"banana", 10.25, []float64{12.5}},
+			{"cherry", 100, nil},
+			{"date", "N/A", []float64{-3}},
+		},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "text",
+			opts: []Option{WithDecimalAlign(true)},
+			want: `+--------+--------+-------+
+| Item   | Price  | Qty   |
++--------+--------+-------+
+| apple  |   1.5  |  1    |
+|        |        |  0.25 |
++--------+--------+-------+
+| banana |  10.25 | 12.5  |
++--------+--------+-------+
+| cherry | 100    | -     |
++--------+--------+-------+
+| date   | N/A    | -3    |
++--------+--------+-------+
+`,
+		},
+		{
+			name: "backlog",
+			opts: []Option{WithFormat(BacklogFormat), WithDecimalAlign(true)},
+			want: `| Item   | Price  | Qty       |h
+| apple  |   1.5  | 1&br;0.25 |
+| banana |  10.25 |      12.5 |
+| cherry | 100    | -         |
+| date   | N/A    |      -3   |
+`,
+		},
+		{
+			name: "plain",
+			opts: []Option{WithFormat(PlainFormat), WithDecimalAlign(true)},
+			want: `Item     Price    Qty
+apple      1.5     1
+                   0.25
+banana    10.25   12.5
+cherry   100      -
+date     N/A      -3
+`,
+		},
+		{
+			name: "decimal_mark",
+			opts: []Option{
+				WithFormat(PlainFormat),
+				WithDecimalAlign(true),
+				WithColumnNumberFormat(1, NumberFormat{Precision: -1, Thousands: ".", DecimalMark: ","}),
+				WithIgnoreFields([]int{2}),
+			},
+			want: `Item     Price
+apple      1,5
+banana    10,25
+cherry   100
+date     N/A
+`,
+		},
+		{
+			name: "styled",
+			opts: []Option{
+				WithFormat(PlainFormat),
+				WithDecimalAlign(true),
+				WithColumnStyle(1, Style{Bold: true}),
+				WithIgnoreFields([]int{2}),
+			},
+			want: "Item     Price\n" +
+				"apple      \x1b[1m1.5\x1b[0m\n" +
+				"banana    \x1b[1m10.25\x1b[0m\n" +
+				"cherry   \x1b[1m100\x1b[0m\n" +
+				"date     \x1b[1mN/A\x1b[0m\n",
+		},
+		{
+			name: "sorted",
+			opts: []Option{
+				WithFormat(PlainFormat),
+				WithDecimalAlign(true),
+				WithSortFunc(func(a, b []string) int { return strings.Compare(b[0], a[0]) }),
+				WithIgnoreFields([]int{2}),
+			},
+			want: `Item     Price
+date     N/A
+cherry   100
+banana    10.25
+apple      1.5
+`,
+		},
+		{
+			name: "disabled",
+			opts: []Option{WithFormat(PlainFormat), WithIgnoreFields([]int{2})},
+			want: `Item     Price
+apple      1.5
+banana   10.25
+cherry     100
+date     N/A
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(in); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_decimalAlign_decorated(t *testing.T) {
+	in := Input{
+		Header: []string{"Ratio", "Size"},
+		Data: [][]any{
+			{0.125, uint64(1536)},
+			{1, uint64(512)},
+			{-0.05, uint64(1048576)},
+		},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "percent",
+			opts: []Option{
+				WithFormat(PlainFormat),
+				WithDecimalAlign(true),
+				WithColumnNumberFormat(0, NumberFormat{Percent: true}),
+				WithIgnoreFields([]int{1}),
+			},
+			want: `Ratio
+ 12.5%
+100%
+ -5%
+`,
+		},
+		{
+			name: "unit_suffix",
+			opts: []Option{
+				WithFormat(PlainFormat),
+				WithDecimalAlign(true),
+				WithColumnNumberFormat(0, NumberFormat{Unit: IECUnit, Suffix: "B"}),
+				WithIgnoreFields([]int{0}),
+			},
+			want: `Size
+  1.5KiB
+512B
+  1MiB
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(in); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
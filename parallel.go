@@ -0,0 +1,71 @@
+package mintab
+
+import (
+	"runtime"
+	"sync"
+)
+
+// formatRows formats n rows into the rows given to fn and pushes them in order. Unless the rows are sorted,
+// a single row is reused and added to the data directly. If n reaches the parallel threshold,
+// the rows are divided into chunks formatted by multiple goroutines, and then pushed sequentially
+// so that merging, styling and column widths are applied as in sequential formatting.
+func (t *Table) formatRows(n int, fn func(i int, row *rawRow) (bool, error)) error {
+	if t.parallelThreshold <= 0 || n < t.parallelThreshold {
+		var row rawRow
+		for i := range n {
+			row = t.nextRow(row)
+			ok, err := fn(i, &row)
+			if err != nil {
+				return err
+			}
+			if ok {
+				t.pushRow(row)
+			}
+		}
+		return nil
+	}
+	workers := min(runtime.GOMAXPROCS(0), n)
+	size := (n + workers - 1) / workers
+	chunks := make([][]rawRow, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for c := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lo, hi := c*size, min((c+1)*size, n)
+			rows := make([]rawRow, 0, max(hi-lo, 0))
+			for i := lo; i < hi; i++ {
+				r := t.newRawRow()
+				ok, err := fn(i, &r)
+				if err != nil {
+					errs[c] = err
+					break
+				}
+				if ok {
+					rows = append(rows, r)
+				}
+			}
+			chunks[c] = rows
+		}()
+	}
+	wg.Wait()
+	for c, rows := range chunks {
+		for _, r := range rows {
+			t.pushRow(r)
+		}
+		if errs[c] != nil {
+			return errs[c]
+		}
+	}
+	return nil
+}
+
+// nextRow returns row to be reused if rows are added to the data directly,
+// or a new row if rows are kept for sorting or row is not allocated yet.
+func (t *Table) nextRow(row rawRow) rawRow {
+	if t.sortFunc != nil || row.cells == nil {
+		return t.newRawRow()
+	}
+	return row
+}
@@ -0,0 +1,89 @@
+package mintab
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTable_formatRows(t *testing.T) {
+	type row struct {
+		Group string
+		Name  string
+		Value int
+		Note  string
+	}
+	rows := make([]row, 0, 1000)
+	input := Input{Header: []string{"Group", "Name", "Value", "Note"}}
+	for i := range 1000 {
+		r := row{
+			Group: fmt.Sprintf("g-%d", i/7),
+			Name:  fmt.Sprintf("name-%d", i),
+			Value: i * 31 % 1000,
+		}
+		if i%3 == 0 {
+			r.Note = "multi\nline"
+		}
+		rows = append(rows, r)
+		input.Data = append(input.Data, []any{r.Group, r.Name, r.Value, r.Note})
+	}
+	opts := []Option{
+		WithMergeFields([]int{0}),
+		WithFilter(func(header []string, row []any) bool {
+			return row[2].(int)%5 != 0
+		}),
+		WithCellStyle(func(row, col int, value any) Style {
+			if row%10 == 0 && col == 1 {
+				return Style{Marker: "* "}
+			}
+			return Style{}
+		}),
+	}
+	tests := []struct {
+		name string
+		v    any
+	}{
+		{
+			name: "input",
+			v:    input,
+		},
+		{
+			name: "struct",
+			v:    rows,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			render := func(threshold int) string {
+				buf := &bytes.Buffer{}
+				table := New(buf, append(opts, WithParallelThreshold(threshold))...)
+				if err := table.Load(tt.v); err != nil {
+					t.Fatal(err)
+				}
+				table.Render()
+				return buf.String()
+			}
+			if diff := cmp.Diff(render(1), render(0)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_formatRows_error(t *testing.T) {
+	data := make([][]any, 100)
+	for i := range data {
+		data[i] = []any{i}
+	}
+	data[10] = []any{struct{}{}}
+	data[90] = []any{1, 2}
+	buf := &bytes.Buffer{}
+	table := New(buf, WithParallelThreshold(1))
+	err := table.Load(Input{Header: []string{"Value"}, Data: data})
+	want := "cannot load input: nested fields not supported"
+	if err == nil || err.Error() != want {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, want)
+	}
+}
@@ -0,0 +1,217 @@
+package mintab
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// An Aggregator reduces the values collected into the same pivot cell into a single value.
+type Aggregator func(values []any) any
+
+// Pivot reshapes v into an Input with one row per distinct value of rowKey and one column per
+// distinct value of colKey, in order of first appearance. Each cell holds the values of valueKey
+// reduced by agg, and cells without values are left empty. v takes the same forms as Table.Load,
+// and an empty Input is returned if v is nil or has no header.
+func Pivot(v any, rowKey, colKey, valueKey string, agg Aggregator) (Input, error) {
+	if agg == nil {
+		return Input{}, fmt.Errorf("cannot pivot input: aggregator is required")
+	}
+	in, err := toInput(v)
+	if err != nil {
+		return Input{}, err
+	}
+	if in.Header == nil {
+		return Input{}, nil
+	}
+	var idx [3]int
+	for i, name := range []string{rowKey, colKey, valueKey} {
+		if idx[i] = slices.Index(in.Header, name); idx[i] < 0 {
+			return Input{}, fmt.Errorf("cannot pivot input: column not found: %q", name)
+		}
+	}
+	var (
+		rowKeys []any
+		colKeys []string
+		rowPos  = make(map[string]int)
+		colPos  = make(map[string]int)
+		cells   = make(map[[2]int][]any)
+	)
+	for _, r := range in.Data {
+		if len(r) != len(in.Header) {
+			return Input{}, fmt.Errorf("cannot pivot input: number of columns must be the same as header")
+		}
+		rk := toString(r[idx[0]])
+		i, ok := rowPos[rk]
+		if !ok {
+			i = len(rowKeys)
+			rowPos[rk] = i
+			rowKeys = append(rowKeys, r[idx[0]])
+		}
+		ck := toString(r[idx[1]])
+		j, ok := colPos[ck]
+		if !ok {
+			j = len(colKeys)
+			colPos[ck] = j
+			colKeys = append(colKeys, ck)
+		}
+		cells[[2]int{i, j}] = append(cells[[2]int{i, j}], r[idx[2]])
+	}
+	out := Input{
+		Header: append([]string{rowKey}, colKeys...),
+		Data:   make([][]any, len(rowKeys)),
+	}
+	for i, rk := range rowKeys {
+		row := make([]any, len(colKeys)+1)
+		row[0] = rk
+		for j := range colKeys {
+			if values, ok := cells[[2]int{i, j}]; ok {
+				row[j+1] = agg(values)
+			}
+		}
+		out.Data[i] = row
+	}
+	return out, nil
+}
+
+// Sum is an Aggregator that returns the sum of numeric values.
+// The result is int64 if all values are integers, otherwise float64.
+func Sum(values []any) any {
+	var (
+		i     int64
+		f     float64
+		float bool
+		found bool
+	)
+	for _, v := range values {
+		rv := reflect.Indirect(reflect.ValueOf(v))
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i += rv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			i += int64(rv.Uint())
+		default:
+			n, ok := toFloat(v)
+			if !ok {
+				continue
+			}
+			f += n
+			float = true
+		}
+		found = true
+	}
+	switch {
+	case !found:
+		return nil
+	case float:
+		return f + float64(i)
+	default:
+		return i
+	}
+}
+
+// Count is an Aggregator that returns the number of values.
+func Count(values []any) any {
+	return len(values)
+}
+
+// Avg is an Aggregator that returns the arithmetic mean of numeric values as float64.
+func Avg(values []any) any {
+	var (
+		sum float64
+		n   int
+	)
+	for _, v := range values {
+		if f, ok := toFloat(v); ok {
+			sum += f
+			n++
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	return sum / float64(n)
+}
+
+// Min is an Aggregator that returns the smallest numeric value.
+func Min(values []any) any {
+	return extreme(values, -1)
+}
+
+// Max is an Aggregator that returns the largest numeric value.
+func Max(values []any) any {
+	return extreme(values, 1)
+}
+
+func extreme(values []any, sign int) any {
+	var (
+		ret any
+		cur float64
+	)
+	for _, v := range values {
+		f, ok := toFloat(v)
+		if !ok {
+			continue
+		}
+		if ret == nil || cmpFloat(f, cur) == sign {
+			ret = v
+			cur = f
+		}
+	}
+	return ret
+}
+
+func toInput(v any) (Input, error) {
+	switch tv := v.(type) {
+	case nil:
+		return Input{}, nil
+	case Input:
+		return tv, nil
+	case *Input:
+		if tv == nil {
+			return Input{}, nil
+		}
+		return *tv, nil
+	case []any:
+		return Input{}, fmt.Errorf("cannot load input: elements of slice must not be any")
+	case RowProvider:
+		return providerInput(tv), nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return Input{}, nil
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		rv = reflect.Append(reflect.MakeSlice(reflect.SliceOf(rv.Type()), 0, 1), rv)
+	}
+	typ := rv.Type().Elem()
+	if typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return Input{}, fmt.Errorf("cannot load input: elements of slice must be struct or pointer to struct")
+	}
+	names, indices := exportedFields(typ)
+	in := Input{
+		Header: names,
+		Data:   make([][]any, 0, rv.Len()),
+	}
+	for i := 0; i < rv.Len(); i++ {
+		e := rv.Index(i)
+		if e.Kind() == reflect.Pointer {
+			if e.IsNil() {
+				continue
+			}
+			e = e.Elem()
+		}
+		row := make([]any, len(indices))
+		for k, x := range indices {
+			row[k] = e.Field(x).Interface()
+		}
+		in.Data = append(in.Data, row)
+	}
+	return in, nil
+}
@@ -0,0 +1,270 @@
+package mintab
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type pivotTestStruct struct {
+	Week    string
+	Service string
+	Cost    float64
+}
+
+func TestPivot(t *testing.T) {
+	data := []pivotTestStruct{
+		{Week: "W1", Service: "EC2", Cost: 10.5},
+		{Week: "W1", Service: "S3", Cost: 2},
+		{Week: "W1", Service: "EC2", Cost: 1.5},
+		{Week: "W2", Service: "S3", Cost: 3},
+		{Week: "W2", Service: "RDS", Cost: 7},
+	}
+	type args struct {
+		v        any
+		rowKey   string
+		colKey   string
+		valueKey string
+		agg      Aggregator
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Input
+		wantErr bool
+	}{
+		{
+			name: "struct_sum",
+			args: args{
+				v:        data,
+				rowKey:   "Week",
+				colKey:   "Service",
+				valueKey: "Cost",
+				agg:      Sum,
+			},
+			want: Input{
+				Header: []string{"Week", "EC2", "S3", "RDS"},
+				Data: [][]any{
+					{"W1", 12.0, 2.0, nil},
+					{"W2", nil, 3.0, 7.0},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "input_count",
+			args: args{
+				v: Input{
+					Header: []string{"Region", "State", "ID"},
+					Data: [][]any{
+						{"us-east-1", "running", "i-1"},
+						{"us-east-1", "stopped", "i-2"},
+						{"ap-northeast-1", "running", "i-3"},
+						{"us-east-1", "running", "i-4"},
+					},
+				},
+				rowKey:   "Region",
+				colKey:   "State",
+				valueKey: "ID",
+				agg:      Count,
+			},
+			want: Input{
+				Header: []string{"Region", "running", "stopped"},
+				Data: [][]any{
+					{"us-east-1", 2, 1},
+					{"ap-northeast-1", 1, nil},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty",
+			args: args{
+				v:        []pivotTestStruct{},
+				rowKey:   "Week",
+				colKey:   "Service",
+				valueKey: "Cost",
+				agg:      Sum,
+			},
+			want: Input{
+				Header: []string{"Week"},
+				Data:   [][]any{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "row_provider",
+			args: args{
+				v: &ringTestProvider{
+					header: []string{"R", "C", "V"},
+					buf:    [][]any{{"r2", "c1", 3}, {"r1", "c1", 1}, {"r1", "c2", 2}, {"r1", "c1", 4}},
+					start:  1,
+				},
+				rowKey:   "R",
+				colKey:   "C",
+				valueKey: "V",
+				agg:      Sum,
+			},
+			want: Input{
+				Header: []string{"R", "c1", "c2"},
+				Data: [][]any{
+					{"r1", int64(5), int64(2)},
+					{"r2", int64(3), nil},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "nil_pointer",
+			args: args{
+				v:        (*pivotTestStruct)(nil),
+				rowKey:   "Week",
+				colKey:   "Service",
+				valueKey: "Cost",
+				agg:      Sum,
+			},
+			want:    Input{},
+			wantErr: false,
+		},
+		{
+			name: "column_not_found",
+			args: args{
+				v:        data,
+				rowKey:   "Month",
+				colKey:   "Service",
+				valueKey: "Cost",
+				agg:      Sum,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no_aggregator",
+			args: args{
+				v:        data,
+				rowKey:   "Week",
+				colKey:   "Service",
+				valueKey: "Cost",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid_input",
+			args: args{
+				v:        []any{1},
+				rowKey:   "Week",
+				colKey:   "Service",
+				valueKey: "Cost",
+				agg:      Sum,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Pivot(tt.args.v, tt.args.rowKey, tt.args.colKey, tt.args.valueKey, tt.args.agg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestPivot_render(t *testing.T) {
+	in, err := Pivot([]pivotTestStruct{
+		{Week: "W1", Service: "EC2", Cost: 10.5},
+		{Week: "W1", Service: "S3", Cost: 2},
+		{Week: "W2", Service: "EC2", Cost: 3.25},
+	}, "Week", "Service", "Cost", Sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	table := New(buf, WithFormat(MarkdownFormat))
+	if err := table.Load(in); err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := `| Week | EC2  | S3 |
+|------|------|----|
+| W1   | 10.5 |  2 |
+| W2   | 3.25 | \- |
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	values := []any{3, "x", 1.5, nil, uint8(4)}
+	tests := []struct {
+		name string
+		agg  Aggregator
+		in   []any
+		want any
+	}{
+		{
+			name: "sum",
+			agg:  Sum,
+			in:   values,
+			want: 8.5,
+		},
+		{
+			name: "sum_int",
+			agg:  Sum,
+			in:   []any{1, int64(2), uint(3)},
+			want: int64(6),
+		},
+		{
+			name: "sum_none",
+			agg:  Sum,
+			in:   []any{"x"},
+			want: nil,
+		},
+		{
+			name: "count",
+			agg:  Count,
+			in:   values,
+			want: 5,
+		},
+		{
+			name: "avg",
+			agg:  Avg,
+			in:   []any{1, 2.0, "3"},
+			want: 2.0,
+		},
+		{
+			name: "avg_none",
+			agg:  Avg,
+			in:   []any{},
+			want: nil,
+		},
+		{
+			name: "min",
+			agg:  Min,
+			in:   values,
+			want: 1.5,
+		},
+		{
+			name: "max",
+			agg:  Max,
+			in:   values,
+			want: uint8(4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.agg(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package mintab
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// RowProvider is implemented by types that expose their own collections as tables,
+// such as trees, caches and ring buffers, without conversion to Input.
+// Load and AppendRows recognize RowProvider before falling back to reflection.
+type RowProvider interface {
+	// Header returns the column names.
+	Header() []string
+
+	// Rows returns an iterator over the rows. Each row must have as many fields as the header.
+	Rows() iter.Seq[[]any]
+}
+
+func (t *Table) loadRows(p RowProvider) error {
+	t.setFormat()
+	if err := t.setInputHeader(Input{Header: p.Header()}); err != nil {
+		return err
+	}
+	t.resetData(0)
+	if err := t.appendRowSeq(p.Rows()); err != nil {
+		return err
+	}
+	t.commitRows()
+	return nil
+}
+
+func (t *Table) appendRows(p RowProvider) error {
+	if t.structType != nil {
+		return fmt.Errorf("cannot append rows: rows cannot be appended to a table loaded from structs")
+	}
+	if !slices.Equal(p.Header(), t.inputHeader) {
+		return fmt.Errorf("cannot append rows: header must be the same as the loaded header")
+	}
+	return t.appendRowSeq(p.Rows())
+}
+
+func (t *Table) appendRowSeq(rows iter.Seq[[]any]) error {
+	if rows == nil {
+		return nil
+	}
+	var row rawRow
+	for r := range rows {
+		row = t.nextRow(row)
+		ok, err := t.formatInputRow(&row, r)
+		if err != nil {
+			return err
+		}
+		if ok {
+			t.pushRow(row)
+		}
+	}
+	return nil
+}
+
+// providerInput collects the header and rows of p into an Input. Rows are copied
+// because providers may reuse the slices yielded by Rows.
+func providerInput(p RowProvider) Input {
+	in := Input{Header: p.Header()}
+	rows := p.Rows()
+	if rows == nil {
+		return in
+	}
+	for r := range rows {
+		in.Data = append(in.Data, slices.Clone(r))
+	}
+	return in
+}
@@ -0,0 +1,155 @@
+package mintab
+
+import (
+	"bytes"
+	"iter"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type ringTestProvider struct {
+	header []string
+	buf    [][]any
+	start  int
+}
+
+func (r *ringTestProvider) Header() []string {
+	return r.header
+}
+
+func (r *ringTestProvider) Rows() iter.Seq[[]any] {
+	return func(yield func([]any) bool) {
+		for i := range r.buf {
+			if !yield(r.buf[(r.start+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+func TestTable_RowProvider(t *testing.T) {
+	type args struct {
+		opts []Option
+		v    RowProvider
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "ring",
+			args: args{
+				opts: []Option{WithFormat(CompressedTextFormat), WithMergeFields([]int{0})},
+				v: &ringTestProvider{
+					header: []string{"Level", "Message"},
+					buf:    [][]any{{"error", "c"}, {"info", "a"}, {"info", "b"}},
+					start:  1,
+				},
+			},
+			want: `+-------+---------+
+| Level | Message |
++-------+---------+
+| info  | a       |
+|       | b       |
++-------+---------+
+| error | c       |
++-------+---------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "ignore_filter",
+			args: args{
+				opts: []Option{
+					WithFormat(MarkdownFormat),
+					WithIgnoreFields([]int{0}),
+					WithFilter(func(header []string, row []any) bool {
+						return row[0] == "info"
+					}),
+				},
+				v: &ringTestProvider{
+					header: []string{"Level", "Message"},
+					buf:    [][]any{{"error", "c"}, {"info", "a"}},
+				},
+			},
+			want: `| Message |
+|---------|
+| a       |
+`,
+			wantErr: false,
+		},
+		{
+			name: "header_only",
+			args: args{
+				v: &ringTestProvider{header: []string{"Level"}},
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "no_header",
+			args: args{
+				v: &ringTestProvider{buf: [][]any{{"info"}}},
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "invalid_columns",
+			args: args{
+				v: &ringTestProvider{
+					header: []string{"Level", "Message"},
+					buf:    [][]any{{"info", "a"}, {"error"}},
+				},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.args.opts...)
+			if err := table.Load(tt.args.v); (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+				return
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_RowProvider_append(t *testing.T) {
+	p := &ringTestProvider{
+		header: []string{"Level", "Message"},
+		buf:    [][]any{{"info", "a"}},
+	}
+	buf := &bytes.Buffer{}
+	table := New(buf, WithFormat(CompressedTextFormat))
+	if err := table.Load(Input{Header: []string{"Level", "Message"}, Data: [][]any{{"debug", "start"}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.AppendRows(p); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.AppendRows(&ringTestProvider{header: []string{"Other"}}); err == nil {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, "error")
+	}
+	table.Render()
+	want := `+-------+---------+
+| Level | Message |
++-------+---------+
+| debug | start   |
+| info  | a       |
++-------+---------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -1,20 +1,77 @@
 package mintab
 
 import (
+	"fmt"
 	"io"
+	"strconv"
 	"strings"
-	"unicode"
-
-	"github.com/mattn/go-runewidth"
 )
 
-// Render renders the table to the writer.
+// Render renders the table to the writer. Write errors are ignored; use RenderE to handle them.
 func (t *Table) Render() {
+	_ = t.RenderE()
+}
+
+// RenderE renders the table to the writer and returns the first write error.
+// Rendering stops as soon as a write fails.
+func (t *Table) RenderE() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.render()
+}
+
+func (t *Table) render() error {
+	t.written, t.err = 0, nil
+	t.sortRows()
 	if t.numRows == 0 {
-		return
+		return nil
+	}
+	t.setStyles()
+	if t.format == VerticalFormat {
+		t.printRecords()
+		return t.err
 	}
 	t.printHeader()
 	t.printData()
+	return t.err
+}
+
+// WriteTo renders the table to w instead of the writer of the table, implementing io.WriterTo.
+// It returns the number of bytes written and the first write error.
+func (t *Table) WriteTo(w io.Writer) (int64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	orig := t.w
+	t.w = w
+	defer func() { t.w = orig }()
+	err := t.render()
+	return t.written, err
+}
+
+// String renders the table into a string, implementing fmt.Stringer.
+func (t *Table) String() string {
+	var b strings.Builder
+	_, _ = t.WriteTo(&b)
+	return b.String()
+}
+
+// Format implements fmt.Formatter so that %v and %s print the rendered table.
+func (t *Table) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		_, _ = t.WriteTo(f)
+	default:
+		_, _ = fmt.Fprintf(f, "%%!%c(*mintab.Table)", verb)
+	}
+}
+
+// Sprint loads v into a new Table with the options and returns the rendered table.
+func Sprint(v any, opts ...Option) (string, error) {
+	t := New(io.Discard, opts...)
+	if err := t.Load(v); err != nil {
+		return "", err
+	}
+	return t.String(), nil
 }
 
 func (t *Table) printHeader() {
@@ -23,19 +80,30 @@ func (t *Table) printHeader() {
 	}
 	b := bufPool.Get().(*strings.Builder)
 	b.Reset()
+	f := t.frame()
 	switch t.format {
 	case TextFormat, CompressedTextFormat:
 		b.Grow(t.tableWidth * 2)
-		b.WriteString(t.border)
+		if f.edge {
+			t.writeBorder(b)
+		}
 	case MarkdownFormat:
 		b.Grow(t.tableWidth)
 	case BacklogFormat:
 		b.Grow(t.tableWidth + 1)
 	}
-	b.WriteString("|")
-	for i, h := range t.header {
-		t.writeField(b, h, t.colWidths[i])
-		b.WriteString("|")
+	if f.edge {
+		t.writeSep(b, f.vertical)
+	}
+	end := len(t.header) - 1
+	if t.format == PlainFormat {
+		end = lastNonEmpty(len(t.header), func(i int) string { return t.header[i] })
+	}
+	for i, h := range t.header[:end+1] {
+		t.writeField(b, h, t.colWidths[i], 0, t.isNumericHeader(i, h), t.headerSGR, "", t.trimEdge(i, end))
+		if f.edge || i < end {
+			t.writeSep(b, f.vertical)
+		}
 	}
 	if t.format == BacklogFormat {
 		b.WriteString("h")
@@ -48,8 +116,11 @@ func (t *Table) printHeader() {
 }
 
 func (t *Table) printData() {
+	f := t.frame()
 	if t.format == TextFormat || t.format == CompressedTextFormat {
-		t.printBorder()
+		if t.hasHeader || f.edge {
+			t.printBorder()
+		}
 	}
 	if t.format == MarkdownFormat {
 		if t.hasHeader || t.numColumns > 0 {
@@ -57,23 +128,13 @@ func (t *Table) printData() {
 		}
 	}
 	for i, r := range t.data {
+		if t.err != nil {
+			return
+		}
 		b := bufPool.Get().(*strings.Builder)
 		b.Reset()
-		if i > 0 {
-			switch t.format {
-			case TextFormat:
-				b.Grow(t.tableWidth * 2)
-				t.writeDataBorder(b, r)
-			case CompressedTextFormat:
-				if r[0][0] == "" || len(t.mergedFields) == 0 {
-					b.Grow(t.tableWidth)
-				} else {
-					b.Grow(t.tableWidth * 2)
-					b.WriteString(t.border)
-				}
-			case MarkdownFormat, BacklogFormat:
-				b.Grow(t.tableWidth)
-			}
+		if i > 0 && (t.format == TextFormat || t.format == CompressedTextFormat) {
+			t.writeRowSeparator(b, i, r)
 		} else {
 			b.Grow(t.tableWidth)
 		}
@@ -84,15 +145,152 @@ func (t *Table) printData() {
 		t.print(s)
 	}
 	if t.format == TextFormat || t.format == CompressedTextFormat {
-		t.printBorder()
+		if f.edge {
+			t.printBorder()
+		}
 	}
 }
 
+func (t *Table) writeRowSeparator(b *strings.Builder, i int, r [][]string) {
+	sep := t.separator
+	if sep == AutoSeparator {
+		sep = EverySeparator
+		if t.format == CompressedTextFormat {
+			sep = GroupSeparator
+		}
+	}
+	switch {
+	case sep == EverySeparator,
+		sep == IntervalSeparator && i%max(t.separatorInterval, 1) == 0:
+		b.Grow(t.tableWidth * 2)
+		t.writeDataBorder(b, r)
+	case sep == GroupSeparator && r[0][0] != "" && len(t.mergedFields) > 0:
+		b.Grow(t.tableWidth * 2)
+		t.writeBorder(b)
+	default:
+		b.Grow(t.tableWidth)
+	}
+}
+
+// An edge is a set of the sides of a field written without the margin and padding.
+type edge uint8
+
+const (
+	leftEdge edge = 1 << iota
+	rightEdge
+)
+
+// trimEdge returns the sides of the field in the column at index col that are trimmed in plain format,
+// where lines have no leading margin and end at the last non-empty field, at index end, without trailing spaces.
+func (t *Table) trimEdge(col, end int) edge {
+	if t.format != PlainFormat {
+		return 0
+	}
+	var e edge
+	if col == 0 {
+		e |= leftEdge
+	}
+	if col == end {
+		e |= rightEdge
+	}
+	return e
+}
+
+// lastNonEmpty returns the index of the last of n fields returned by field that is not empty, or -1 if all are empty.
+func lastNonEmpty(n int, field func(i int) string) int {
+	for i := n - 1; i >= 0; i-- {
+		if field(i) != "" {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) printRecords() {
+	keyWidth := 0
+	for _, h := range t.header {
+		if w := stringWidth(h); w > keyWidth {
+			keyWidth = w
+		}
+	}
+	valueWidth := 0
+	for _, r := range t.data {
+		for _, elems := range r {
+			for _, elem := range elems {
+				if w := stringWidth(elem); w > valueWidth {
+					valueWidth = w
+				}
+			}
+		}
+	}
+	f := t.frame()
+	for i, r := range t.data {
+		if t.err != nil {
+			return
+		}
+		b := bufPool.Get().(*strings.Builder)
+		b.Reset()
+		b.Grow((keyWidth + valueWidth + t.marginWidthBothSides + 2) * (t.lineHeights[i] + t.numColumns))
+		if t.hasHeader {
+			t.writeRecordHeader(b, i+1, keyWidth, valueWidth)
+		} else if i > 0 {
+			b.WriteString("\n")
+		}
+		for k, elems := range r {
+			for j, elem := range elems {
+				key := ""
+				if j == 0 {
+					key = t.header[k]
+				}
+				writeStyled(b, key, t.headerSGR)
+				for range keyWidth - stringWidth(key) {
+					b.WriteByte(' ')
+				}
+				b.WriteString(t.margin)
+				t.writeSep(b, f.vertical)
+				b.WriteString(t.margin)
+				writeStyled(b, elem, t.colSGR(k))
+				b.WriteString("\n")
+			}
+		}
+		s := b.String()
+		b.Reset()
+		bufPool.Put(b)
+		t.print(s)
+	}
+}
+
+func (t *Table) writeRecordHeader(b *strings.Builder, n, keyWidth, valueWidth int) {
+	f := t.frame()
+	fill := f.horizontal
+	if fill == "" {
+		fill = " "
+	}
+	label := "-[ RECORD " + strconv.Itoa(n) + " ]"
+	b.WriteString(t.borderSGR)
+	b.WriteString(label)
+	if keyWidth+t.marginWidth < len(label) || f.horizontal == "" {
+		for range keyWidth + valueWidth + t.marginWidthBothSides + 1 - len(label) {
+			b.WriteString(f.horizontal)
+		}
+		t.endLine(b)
+		return
+	}
+	for range keyWidth + t.marginWidth - len(label) {
+		b.WriteString(fill)
+	}
+	b.WriteString(f.cross)
+	for range valueWidth + t.marginWidth {
+		b.WriteString(fill)
+	}
+	t.endLine(b)
+}
+
 func (t *Table) printBorder() {
 	b := bufPool.Get().(*strings.Builder)
 	b.Reset()
 	b.Grow(t.tableWidth)
-	b.WriteString(t.border)
+	t.writeBorder(b)
 	s := b.String()
 	b.Reset()
 	bufPool.Put(b)
@@ -100,83 +298,218 @@ func (t *Table) printBorder() {
 }
 
 func (t *Table) print(s string) {
-	_, _ = io.WriteString(t.w, s)
+	if t.err != nil {
+		return
+	}
+	n, err := io.WriteString(t.w, s)
+	t.written += int64(n)
+	t.err = err
 }
 
 func (t *Table) writeRow(b *strings.Builder, i int) {
+	f := t.frame()
+	stripe := ""
+	if i%2 == 1 {
+		stripe = t.stripeSGR
+	}
+	row := t.data[i]
 	for j := 0; j < t.lineHeights[i]; j++ {
-		b.WriteString("|")
-		for k, elems := range t.data[i] {
+		if f.edge {
+			t.writeSep(b, f.vertical)
+		}
+		end := len(row) - 1
+		if t.format == PlainFormat {
+			end = lastNonEmpty(len(row), func(k int) string {
+				if j < len(row[k]) {
+					return row[k][j]
+				}
+				return ""
+			})
+		}
+		for k, elems := range row[:end+1] {
+			e := t.trimEdge(k, end)
 			if j < len(elems) {
-				t.writeField(b, elems[j], t.colWidths[k])
+				plain := elems[j]
+				if i < len(t.unstyled) && j < len(t.unstyled[i][k]) {
+					plain = t.unstyled[i][k][j]
+				}
+				isN, tail := t.isNumeric(k, plain), 0
+				if isN {
+					tail = t.decimalPad(k, elems[j], plain)
+				}
+				t.writeField(b, elems[j], t.colWidths[k], tail, isN, t.colSGR(k), stripe, e)
 			} else {
-				t.writeField(b, "", t.colWidths[k])
+				t.writeField(b, "", t.colWidths[k], 0, false, "", stripe, e)
+			}
+			if f.edge || k < end {
+				t.writeSep(b, f.vertical)
 			}
-			b.WriteString("|")
 		}
 		b.WriteString("\n")
 	}
 }
 
 func (t *Table) writeDataBorder(b *strings.Builder, row [][]string) {
-	sep := "+"
+	f := t.frame()
+	if f.horizontal == "" {
+		return
+	}
+	b.WriteString(t.borderSGR)
 	for i, field := range row {
-		b.WriteString(sep)
+		if i > 0 || f.edge {
+			b.WriteString(f.cross)
+		}
 		v := " "
 		if field[0] != "" {
-			v = "-"
+			v = f.horizontal
 		}
 		for j := 0; j < t.colWidths[i]+t.marginWidthBothSides; j++ {
 			b.WriteString(v)
 		}
 	}
-	b.WriteString(sep)
+	if f.edge {
+		b.WriteString(f.cross)
+	}
+	t.endLine(b)
+}
+
+func (t *Table) endLine(b *strings.Builder) {
+	if t.borderSGR != "" {
+		b.WriteString(sgrReset)
+	}
 	b.WriteString("\n")
 }
 
-func (t *Table) writeField(b *strings.Builder, s string, w int) {
-	b.WriteString(t.margin)
-	isN := isNum(s)
+// writeField writes s padded to width w, where numbers are right-aligned followed by tail spaces.
+// The margin is omitted on the left edge, and the margin and trailing spaces on the right edge.
+func (t *Table) writeField(b *strings.Builder, s string, w, tail int, isN bool, sgr, stripe string, e edge) {
+	b.WriteString(stripe)
+	if e&leftEdge == 0 {
+		b.WriteString(t.margin)
+	}
 	if !isN {
-		b.WriteString(s)
+		writeStyled(b, s, sgr)
+		b.WriteString(stripe)
 	}
-	pad := w - runewidth.StringWidth(s)
-	if pad > 0 {
+	pad := w - stringWidth(s)
+	if isN {
+		pad -= tail
+	}
+	if pad > 0 && (isN || e&rightEdge == 0) {
 		for range pad {
 			b.WriteByte(' ')
 		}
 	}
 	if isN {
+		writeStyled(b, s, sgr)
+		b.WriteString(stripe)
+		if e&rightEdge == 0 {
+			for range tail {
+				b.WriteByte(' ')
+			}
+		}
+	}
+	if e&rightEdge == 0 {
+		b.WriteString(t.margin)
+	}
+	if stripe != "" {
+		b.WriteString(sgrReset)
+	}
+}
+
+func writeStyled(b *strings.Builder, s, sgr string) {
+	if sgr == "" || s == "" {
 		b.WriteString(s)
+		return
 	}
-	b.WriteString(t.margin)
+	b.WriteString(sgr)
+	b.WriteString(s)
+	b.WriteString(sgrReset)
+}
+
+// isNumeric reports whether s in the column at index col is right-aligned as a number,
+// based on the type of the column and the number format.
+func (t *Table) isNumeric(col int, s string) bool {
+	switch t.columnTypes[col] {
+	case StringColumnType:
+		return false
+	case NumberColumnType:
+		return s != ""
+	}
+	if o, n, ok := strings.Cut(s, DiffArrow); ok {
+		return t.isNumeric(col, o) && t.isNumeric(col, n)
+	}
+	s = stripANSI(s)
+	if f := t.numberFormat(col); f != nil {
+		return f.isNum(s)
+	}
+	return isNum(s)
+}
+
+// isNumericHeader reports whether the header h of the column at index col is right-aligned,
+// based on the type of the column.
+func (t *Table) isNumericHeader(col int, h string) bool {
+	switch t.columnTypes[col] {
+	case StringColumnType:
+		return false
+	case NumberColumnType:
+		return h != ""
+	}
+	return isNum(stripANSI(h))
 }
 
 func isNum(s string) bool {
+	return isNumber(s, ",", ".")
+}
+
+// isNumber reports whether s is a decimal number with an optional sign, groups of digits separated by thousands,
+// a fractional part after mark and an exponent, or an infinity or NaN as formatted by strconv.FormatFloat.
+func isNumber(s, thousands, mark string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
 	if len(s) == 0 {
 		return false
 	}
-	start := 0
-	if s[0] == '-' {
-		start = 1
-		if len(s) == 1 {
+	if s == "Inf" || s == "NaN" {
+		return true
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp := s[i+1:]
+		if len(exp) > 0 && (exp[0] == '+' || exp[0] == '-') {
+			exp = exp[1:]
+		}
+		if !isDigits(exp) {
 			return false
 		}
+		s = s[:i]
 	}
-	n := 0
-	d := false
-	for i := start; i < len(s); i++ {
-		if s[i] == '.' {
-			n++
-			if n > 1 {
-				return false
-			}
-			continue
+	integer, fraction, hasFraction := strings.Cut(s, mark)
+	if hasFraction && fraction != "" && !isDigits(fraction) {
+		return false
+	}
+	if integer == "" {
+		return fraction != ""
+	}
+	if thousands == "" || !strings.Contains(integer, thousands) {
+		return isDigits(integer)
+	}
+	for i, group := range strings.Split(integer, thousands) {
+		if !isDigits(group) || len(group) > 3 || i > 0 && len(group) != 3 {
+			return false
 		}
-		if !unicode.IsDigit(rune(s[i])) {
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
 			return false
 		}
-		d = true
 	}
-	return d
+	return true
 }
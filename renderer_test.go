@@ -2,6 +2,9 @@ package mintab
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"reflect"
 	"testing"
 
@@ -204,6 +207,197 @@ func TestTable_Render(t *testing.T) {
 	}
 }
 
+type limitWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > w.limit {
+		n, _ := w.buf.Write(p[:w.limit-w.buf.Len()])
+		return n, errors.New("no space left on device")
+	}
+	return w.buf.Write(p)
+}
+
+func TestTable_RenderE(t *testing.T) {
+	data := Input{
+		Header: []string{"Name", "Count"},
+		Data:   [][]any{{"a", 1}, {"b", 2}, {"c", 3}},
+	}
+	const full = `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
+| b    |     2 |
+| c    |     3 |
++------+-------+
+`
+	tests := []struct {
+		name    string
+		format  Format
+		limit   int
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "ok",
+			format:  CompressedTextFormat,
+			limit:   len(full),
+			want:    full,
+			wantErr: false,
+		},
+		{
+			name:    "header",
+			format:  CompressedTextFormat,
+			limit:   20,
+			want:    full[:20],
+			wantErr: true,
+		},
+		{
+			name:    "data",
+			format:  CompressedTextFormat,
+			limit:   70,
+			want:    full[:70],
+			wantErr: true,
+		},
+		{
+			name:    "vertical",
+			format:  VerticalFormat,
+			limit:   30,
+			want:    "-[ RECORD 1 ]\nName  | a\nCount ",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &limitWriter{limit: tt.limit}
+			table := New(w, WithFormat(tt.format))
+			if err := table.Load(data); err != nil {
+				t.Fatal(err)
+			}
+			err := table.RenderE()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(w.buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_WriteTo(t *testing.T) {
+	orig := new(bytes.Buffer)
+	table := New(orig, WithFormat(MarkdownFormat))
+	if err := table.Load(Input{Header: []string{"Name"}, Data: [][]any{{"a"}}}); err != nil {
+		t.Fatal(err)
+	}
+	var _ io.WriterTo = table
+	buf := new(bytes.Buffer)
+	n, err := table.WriteTo(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "| Name |\n|------|\n| a    |\n"
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+	if n != int64(len(want)) {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", n, len(want))
+	}
+	if orig.Len() != 0 {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", orig.String(), "")
+	}
+	n, err = table.WriteTo(&limitWriter{limit: 10})
+	if err == nil {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, "error")
+	}
+	if n != 10 {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", n, 10)
+	}
+}
+
+func TestTable_String(t *testing.T) {
+	buf := new(bytes.Buffer)
+	table := New(buf, WithFormat(CompressedTextFormat))
+	if err := table.Load(Input{Header: []string{"Name", "Count"}, Data: [][]any{{"a", 1}}}); err != nil {
+		t.Fatal(err)
+	}
+	want := `+------+-------+
+| Name | Count |
++------+-------+
+| a    |     1 |
++------+-------+
+`
+	if diff := cmp.Diff(table.String(), want); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(fmt.Sprintf("%v", table), want); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(fmt.Sprintf("%s", table), want); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(fmt.Sprintf("%d", table), "%!d(*mintab.Table)"); diff != "" {
+		t.Error(diff)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("\ngot:\n%v\nwant:\n%v\n", buf.String(), "")
+	}
+}
+
+func TestSprint(t *testing.T) {
+	type args struct {
+		v    any
+		opts []Option
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "markdown",
+			args: args{
+				v:    Input{Header: []string{"Name"}, Data: [][]any{{"a"}}},
+				opts: []Option{WithFormat(MarkdownFormat)},
+			},
+			want:    "| Name |\n|------|\n| a    |\n",
+			wantErr: false,
+		},
+		{
+			name: "empty",
+			args: args{
+				v: []struct{ Name string }{},
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "invalid",
+			args: args{
+				v: 1,
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sprint(tt.args.v, tt.args.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", err, tt.wantErr)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
 func TestTable_printHeader(t *testing.T) {
 	type fields struct {
 		header      []string
@@ -630,6 +824,125 @@ func Test_isNum(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "int_plus",
+			args: args{
+				s: "+5",
+			},
+			want: true,
+		},
+		{
+			name: "int_grouped",
+			args: args{
+				s: "1,000",
+			},
+			want: true,
+		},
+		{
+			name: "float_grouped",
+			args: args{
+				s: "-1,234,567.89",
+			},
+			want: true,
+		},
+		{
+			name: "invalid_group",
+			args: args{
+				s: "1,00",
+			},
+			want: false,
+		},
+		{
+			name: "invalid_first_group",
+			args: args{
+				s: "1234,567",
+			},
+			want: false,
+		},
+		{
+			name: "exponent",
+			args: args{
+				s: "1e10",
+			},
+			want: true,
+		},
+		{
+			name: "exponent_signed",
+			args: args{
+				s: "-1.5E-3",
+			},
+			want: true,
+		},
+		{
+			name: "invalid_exponent",
+			args: args{
+				s: "1e",
+			},
+			want: false,
+		},
+		{
+			name: "inf",
+			args: args{
+				s: "+Inf",
+			},
+			want: true,
+		},
+		{
+			name: "nan",
+			args: args{
+				s: "NaN",
+			},
+			want: true,
+		},
+		{
+			name: "leading_point",
+			args: args{
+				s: ".5",
+			},
+			want: true,
+		},
+		{
+			name: "sign_only",
+			args: args{
+				s: "-",
+			},
+			want: false,
+		},
+		{
+			name: "point_only",
+			args: args{
+				s: ".",
+			},
+			want: false,
+		},
+		{
+			name: "inf_lower",
+			args: args{
+				s: "inf",
+			},
+			want: false,
+		},
+		{
+			name: "nan_mixed_case",
+			args: args{
+				s: "Nan",
+			},
+			want: false,
+		},
+		{
+			name: "infinity",
+			args: args{
+				s: "Infinity",
+			},
+			want: false,
+		},
+		{
+			name: "word",
+			args: args{
+				s: "information",
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -640,3 +953,225 @@ func Test_isNum(t *testing.T) {
 		})
 	}
 }
+
+func TestTable_isNumeric(t *testing.T) {
+	type account struct {
+		Account string
+		Port    int
+		Size    string
+		Note    any
+	}
+	accounts := []account{
+		{Account: "012345678901", Port: -1, Size: "1e10", Note: "n/a"},
+		{Account: "123456789012", Port: 443, Size: "1,000", Note: 10},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "auto",
+			opts: []Option{WithFormat(PlainFormat)},
+			want: `Account        Port   Size    Note
+012345678901     -1    1e10   n/a
+123456789012    443   1,000     10
+`,
+		},
+		{
+			name: "hints",
+			opts: []Option{
+				WithFormat(PlainFormat),
+				WithColumnType(0, StringColumnType),
+				WithColumnType(1, StringColumnType),
+				WithColumnType(3, NumberColumnType),
+			},
+			want: `Account        Port   Size    Note
+012345678901   -1      1e10    n/a
+123456789012   443    1,000     10
+`,
+		},
+		{
+			name: "auto_hint",
+			opts: []Option{WithFormat(PlainFormat), WithColumnType(1, AutoColumnType), WithIgnoreFields([]int{0, 2, 3})},
+			want: `Port
+  -1
+ 443
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(accounts); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_isNumericHeader(t *testing.T) {
+	type person struct {
+		Name string
+		ID   int
+	}
+	people := []person{
+		{Name: "Nan", ID: 1234567},
+		{Name: "inf", ID: 42},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "auto",
+			opts: []Option{WithFormat(PlainFormat)},
+			want: `Name   ID
+Nan    1234567
+inf         42
+`,
+		},
+		{
+			name: "number",
+			opts: []Option{WithFormat(PlainFormat), WithColumnType(1, NumberColumnType)},
+			want: `Name        ID
+Nan    1234567
+inf         42
+`,
+		},
+		{
+			name: "string",
+			opts: []Option{WithFormat(PlainFormat), WithColumnType(0, StringColumnType), WithColumnType(1, StringColumnType)},
+			want: `Name   ID
+Nan    1234567
+inf    42
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(people); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_writeRowSeparator(t *testing.T) {
+	data := Input{
+		Header: []string{"Group", "Name"},
+		Data: [][]any{
+			{"a", "a-1"},
+			{"a", "a-2"},
+			{"a", "a-3"},
+			{"b", "b-1"},
+			{"b", "b-2"},
+		},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "every_compressed",
+			opts: []Option{WithFormat(CompressedTextFormat), WithRowSeparator(EverySeparator)},
+			want: `+-------+------+
+| Group | Name |
++-------+------+
+| a     | a-1  |
++-------+------+
+| a     | a-2  |
++-------+------+
+| a     | a-3  |
++-------+------+
+| b     | b-1  |
++-------+------+
+| b     | b-2  |
++-------+------+
+`,
+		},
+		{
+			name: "interval",
+			opts: []Option{WithRowSeparatorInterval(2)},
+			want: `+-------+------+
+| Group | Name |
++-------+------+
+| a     | a-1  |
+| a     | a-2  |
++-------+------+
+| a     | a-3  |
+| b     | b-1  |
++-------+------+
+| b     | b-2  |
++-------+------+
+`,
+		},
+		{
+			name: "group",
+			opts: []Option{WithRowSeparator(GroupSeparator), WithMergeFields([]int{0})},
+			want: `+-------+------+
+| Group | Name |
++-------+------+
+| a     | a-1  |
+|       | a-2  |
+|       | a-3  |
++-------+------+
+| b     | b-1  |
+|       | b-2  |
++-------+------+
+`,
+		},
+		{
+			name: "none",
+			opts: []Option{WithRowSeparator(NoSeparator)},
+			want: `+-------+------+
+| Group | Name |
++-------+------+
+| a     | a-1  |
+| a     | a-2  |
+| a     | a-3  |
+| b     | b-1  |
+| b     | b-2  |
++-------+------+
+`,
+		},
+		{
+			name: "markdown_ignored",
+			opts: []Option{WithFormat(MarkdownFormat), WithRowSeparator(EverySeparator)},
+			want: `| Group | Name |
+|-------|------|
+| a     | a-1  |
+| a     | a-2  |
+| a     | a-3  |
+| b     | b-1  |
+| b     | b-2  |
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(data); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
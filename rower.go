@@ -0,0 +1,81 @@
+package mintab
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// Rower is implemented by struct types that format their rows without reflection,
+// typically with the methods generated by cmd/mintabgen.
+// Load and AppendRows prefer Rower when the struct or its pointer implements it.
+// Ignored and merged field indices refer to the columns of MintabHeader.
+// Options that format raw field values, such as WithColumnFormatter and WithNumberFormat, do not apply to its rows.
+type Rower interface {
+	// MintabHeader returns the column names.
+	MintabHeader() []string
+
+	// MintabRow appends the formatted fields to dst and returns the extended slice.
+	MintabRow(dst []string) []string
+}
+
+var rowerType = reflect.TypeFor[Rower]()
+
+func isRower(typ reflect.Type) bool {
+	return reflect.PointerTo(typ).Implements(rowerType)
+}
+
+func rowerOf(e reflect.Value) Rower {
+	if !e.CanAddr() {
+		v := reflect.New(e.Type()).Elem()
+		v.Set(e)
+		e = v
+	}
+	return e.Addr().Interface().(Rower)
+}
+
+func (t *Table) setRowerHeader(e reflect.Value) error {
+	header := rowerOf(e).MintabHeader()
+	t.isRower = true
+	t.inputHeader = header
+	t.header = slices.Grow(t.header[:0], len(header))
+	t.inputColumns = slices.Grow(t.inputColumns[:0], len(header))
+	t.colWidths = slices.Grow(t.colWidths[:0], len(header))
+	for i, h := range header {
+		if !slices.Contains(t.ignoredFields, i) {
+			t.inputColumns = append(t.inputColumns, i)
+			h = t.headerName(h)
+			t.header = append(t.header, h)
+			t.colWidths = append(t.colWidths, stringWidth(h))
+		}
+	}
+	t.numColumns = len(t.colWidths)
+	t.numColumnsFirstRow = len(header)
+	if t.numColumns == 0 {
+		return fmt.Errorf("cannot load input: at least one column is required")
+	}
+	return nil
+}
+
+func (t *Table) formatRowerRow(row *rawRow, e reflect.Value) (bool, error) {
+	p := rowPool.Get().(*[]string)
+	defer rowPool.Put(p)
+	fields := rowerOf(e).MintabRow((*p)[:0])
+	*p = fields[:0]
+	if len(fields) != len(t.inputHeader) {
+		return false, fmt.Errorf("cannot load input: number of columns must be the same as header")
+	}
+	if len(t.filters) > 0 {
+		values := make([]any, len(fields))
+		for i, f := range fields {
+			values[i] = f
+		}
+		if !t.filter(t.inputHeader, values) {
+			return false, nil
+		}
+	}
+	for k, i := range t.inputColumns {
+		row.set(k, t.sanitize(fields[i]), fields[i])
+	}
+	return true, nil
+}
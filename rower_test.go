@@ -0,0 +1,149 @@
+package mintab
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type rowerTestStruct struct {
+	Group string
+	Name  string
+	Count int
+	Tags  []string
+}
+
+func (v *rowerTestStruct) MintabHeader() []string {
+	return []string{"Group", "Name", "Count", "Tags"}
+}
+
+func (v *rowerTestStruct) MintabRow(dst []string) []string {
+	dst = append(dst, v.Group)
+	dst = append(dst, v.Name)
+	dst = append(dst, strconv.FormatInt(int64(v.Count), 10))
+	dst = append(dst, strings.Join(v.Tags, "\n"))
+	return dst
+}
+
+type reflectTestStruct struct {
+	Group string
+	Name  string
+	Count int
+	Tags  []string
+}
+
+func TestTable_Rower(t *testing.T) {
+	rows := []rowerTestStruct{
+		{Group: "a", Name: "web", Count: 10, Tags: []string{"prod", "app"}},
+		{Group: "a", Name: "db", Count: 2},
+		{Group: "b", Name: "cache", Count: 3, Tags: []string{"*"}},
+	}
+	filter, err := ParseFilter("Count >= 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refs := make([]reflectTestStruct, len(rows))
+	for i, r := range rows {
+		refs[i] = reflectTestStruct(r)
+	}
+	tests := []struct {
+		name string
+		opts []Option
+	}{
+		{
+			name: "default",
+			opts: nil,
+		},
+		{
+			name: "markdown",
+			opts: []Option{WithFormat(MarkdownFormat)},
+		},
+		{
+			name: "ignore_merge",
+			opts: []Option{WithFormat(CompressedTextFormat), WithIgnoreFields([]int{1}), WithMergeFields([]int{0})},
+		},
+		{
+			name: "filter",
+			opts: []Option{WithFilter(filter), WithUpperCaseHeader(true)},
+		},
+		{
+			name: "parallel",
+			opts: []Option{WithParallelThreshold(1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			render := func(v any) string {
+				buf := &bytes.Buffer{}
+				table := New(buf, tt.opts...)
+				if err := table.Load(v); err != nil {
+					t.Fatal(err)
+				}
+				table.Render()
+				return buf.String()
+			}
+			want := render(refs)
+			if diff := cmp.Diff(render(rows), want); diff != "" {
+				t.Error(diff)
+			}
+			ptrs := []*rowerTestStruct{&rows[0], &rows[1], &rows[2]}
+			if diff := cmp.Diff(render(ptrs), want); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(render([3]rowerTestStruct(rows)), want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_Rower_ignoreMerge(t *testing.T) {
+	rows := []rowerTestStruct{
+		{Group: "a", Name: "web", Count: 1},
+		{Group: "a", Name: "web", Count: 2},
+		{Group: "b", Name: "db", Count: 2},
+	}
+	buf := &bytes.Buffer{}
+	table := New(buf, WithFormat(CompressedTextFormat), WithIgnoreFields([]int{0}), WithMergeFields([]int{1}))
+	if err := table.Load(rows); err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := `+------+-------+------+
+| Name | Count | Tags |
++------+-------+------+
+| web  |     1 | -    |
+|      |     2 | -    |
++------+-------+------+
+| db   |     2 | -    |
++------+-------+------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestTable_Rower_append(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := New(buf, WithFormat(CompressedTextFormat), WithIgnoreFields([]int{3}))
+	if err := table.Load(rowerTestStruct{Group: "a", Name: "web", Count: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := table.AppendRows([]rowerTestStruct{{Group: "b", Name: "database", Count: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := `+-------+----------+-------+
+| Group | Name     | Count |
++-------+----------+-------+
+| a     | web      |    10 |
+| b     | database |     2 |
++-------+----------+-------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,304 @@
+package mintab
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	sgrPrefix = "\x1b["
+	sgrReset  = "\x1b[0m"
+)
+
+// A Color represents an ANSI terminal color.
+type Color int
+
+const (
+	// DefaultColor leaves the terminal color unchanged.
+	DefaultColor Color = iota
+
+	// Black is ANSI black.
+	Black
+
+	// Red is ANSI red.
+	Red
+
+	// Green is ANSI green.
+	Green
+
+	// Yellow is ANSI yellow.
+	Yellow
+
+	// Blue is ANSI blue.
+	Blue
+
+	// Magenta is ANSI magenta.
+	Magenta
+
+	// Cyan is ANSI cyan.
+	Cyan
+
+	// White is ANSI white.
+	White
+
+	// BrightBlack is ANSI bright black (gray).
+	BrightBlack
+
+	// BrightRed is ANSI bright red.
+	BrightRed
+
+	// BrightGreen is ANSI bright green.
+	BrightGreen
+
+	// BrightYellow is ANSI bright yellow.
+	BrightYellow
+
+	// BrightBlue is ANSI bright blue.
+	BrightBlue
+
+	// BrightMagenta is ANSI bright magenta.
+	BrightMagenta
+
+	// BrightCyan is ANSI bright cyan.
+	BrightCyan
+
+	// BrightWhite is ANSI bright white.
+	BrightWhite
+)
+
+var colorNames = [...]string{
+	Black:         "black",
+	Red:           "red",
+	Green:         "green",
+	Yellow:        "yellow",
+	Blue:          "blue",
+	Magenta:       "magenta",
+	Cyan:          "cyan",
+	White:         "white",
+	BrightBlack:   "#7f7f7f",
+	BrightRed:     "#ff5555",
+	BrightGreen:   "#55ff55",
+	BrightYellow:  "#ffff55",
+	BrightBlue:    "#5555ff",
+	BrightMagenta: "#ff55ff",
+	BrightCyan:    "#55ffff",
+	BrightWhite:   "#ffffff",
+}
+
+func (c Color) name() string {
+	if c <= DefaultColor || int(c) >= len(colorNames) {
+		return ""
+	}
+	return colorNames[c]
+}
+
+func (c Color) code(base int) int {
+	if c > White {
+		return base + 60 + int(c-BrightBlack)
+	}
+	return base + int(c-Black)
+}
+
+// A Style represents text attributes rendered with ANSI SGR escape sequences in text formats.
+// Styles of cells set by WithCellStyle are also rendered with markup in markdown and backlog formats where possible.
+// The zero value leaves the text unstyled.
+type Style struct {
+	Foreground Color  // Text color
+	Background Color  // Background color
+	Bold       bool   // Bold or increased intensity
+	Faint      bool   // Faint or decreased intensity
+	Italic     bool   // Italic
+	Underline  bool   // Underline
+	Marker     string // Text prepended to the cell value (cell styles only)
+}
+
+// A StyleFunc returns the style of a cell. row is the index of the rendered row,
+// col is the index of the rendered column after ignored fields are removed, and value is the raw field value.
+type StyleFunc func(row, col int, value any) Style
+
+func (s Style) sgr() string {
+	var params []string
+	if s.Bold {
+		params = append(params, "1")
+	}
+	if s.Faint {
+		params = append(params, "2")
+	}
+	if s.Italic {
+		params = append(params, "3")
+	}
+	if s.Underline {
+		params = append(params, "4")
+	}
+	if s.Foreground != DefaultColor {
+		params = append(params, strconv.Itoa(s.Foreground.code(30)))
+	}
+	if s.Background != DefaultColor {
+		params = append(params, strconv.Itoa(s.Background.code(40)))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return sgrPrefix + strings.Join(params, ";") + "m"
+}
+
+func (s Style) markdown(v string) string {
+	if s.Bold {
+		v = "**" + v + "**"
+	}
+	if s.Italic {
+		v = "_" + v + "_"
+	}
+	if s.Underline {
+		v = "<ins>" + v + "</ins>"
+	}
+	var css []string
+	if c := s.Foreground.name(); c != "" {
+		css = append(css, "color:"+c)
+	}
+	if c := s.Background.name(); c != "" {
+		css = append(css, "background-color:"+c)
+	}
+	if s.Faint {
+		css = append(css, "opacity:0.5")
+	}
+	if len(css) > 0 {
+		v = `<span style="` + strings.Join(css, ";") + `;">` + v + "</span>"
+	}
+	return v
+}
+
+func (s Style) backlog(v string) string {
+	if s.Bold {
+		v = "''" + v + "''"
+	}
+	if s.Italic {
+		v = "'''" + v + "'''"
+	}
+	fg, bg := s.Foreground.name(), s.Background.name()
+	switch {
+	case bg != "":
+		if fg == "" {
+			fg = "black"
+		}
+		v = "&color(" + fg + ", " + bg + ") { " + v + " }"
+	case fg != "":
+		v = "&color(" + fg + ") { " + v + " }"
+	}
+	return v
+}
+
+func (t *Table) styleCell(row, col int, value any, s string) string {
+	if t.cellStyle == nil || s == "" {
+		return s
+	}
+	style := t.cellStyle(row, col, value)
+	if style == (Style{}) {
+		return s
+	}
+	s = style.Marker + s
+	switch t.format {
+	case MarkdownFormat:
+		return style.markdown(s)
+	case BacklogFormat:
+		return style.backlog(s)
+	default:
+		sgr := style.sgr()
+		if sgr == "" {
+			return s
+		}
+		if strings.IndexByte(s, '\n') < 0 {
+			return sgr + s + sgrReset
+		}
+		return sgr + strings.ReplaceAll(s, "\n", sgrReset+"\n"+sgr) + sgrReset
+	}
+}
+
+func (t *Table) isANSI() bool {
+	switch t.format {
+	case TextFormat, CompressedTextFormat, VerticalFormat, PlainFormat:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *Table) setStyles() {
+	t.headerSGR, t.borderSGR, t.stripeSGR, t.colSGRs = "", "", "", nil
+	if !t.isANSI() {
+		return
+	}
+	t.headerSGR = t.headerStyle.sgr()
+	t.borderSGR = t.borderStyle.sgr()
+	t.stripeSGR = t.stripeStyle.sgr()
+	if len(t.columnStyles) == 0 {
+		return
+	}
+	t.colSGRs = make([]string, t.numColumns)
+	for i, style := range t.columnStyles {
+		if i >= 0 && i < t.numColumns {
+			t.colSGRs[i] = style.sgr()
+		}
+	}
+}
+
+func (t *Table) colSGR(i int) string {
+	if i < len(t.colSGRs) {
+		return t.colSGRs[i]
+	}
+	return ""
+}
+
+func (t *Table) writeSep(b *strings.Builder, sep string) {
+	if t.borderSGR == "" {
+		b.WriteString(sep)
+		return
+	}
+	b.WriteString(t.borderSGR)
+	b.WriteString(sep)
+	b.WriteString(sgrReset)
+}
+
+func (t *Table) writeBorder(b *strings.Builder) {
+	if t.border == "" {
+		return
+	}
+	if t.borderSGR == "" {
+		b.WriteString(t.border)
+		return
+	}
+	b.WriteString(t.borderSGR)
+	b.WriteString(strings.TrimSuffix(t.border, "\n"))
+	b.WriteString(sgrReset)
+	b.WriteString("\n")
+}
+
+func stringWidth(s string) int {
+	return runewidth.StringWidth(stripANSI(s))
+}
+
+func stripANSI(s string) string {
+	i := strings.Index(s, sgrPrefix)
+	if i < 0 {
+		return s
+	}
+	var b strings.Builder
+	b.Grow(len(s))
+	for i >= 0 {
+		b.WriteString(s[:i])
+		s = s[i+len(sgrPrefix):]
+		j := 0
+		for j < len(s) && (s[j] < 0x40 || s[j] > 0x7e) {
+			j++
+		}
+		if j < len(s) {
+			j++
+		}
+		s = s[j:]
+		i = strings.Index(s, sgrPrefix)
+	}
+	b.WriteString(s)
+	return b.String()
+}
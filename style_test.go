@@ -0,0 +1,459 @@
+package mintab
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStyle_sgr(t *testing.T) {
+	tests := []struct {
+		name  string
+		style Style
+		want  string
+	}{
+		{
+			name:  "zero",
+			style: Style{},
+			want:  "",
+		},
+		{
+			name:  "foreground",
+			style: Style{Foreground: Red},
+			want:  "\x1b[31m",
+		},
+		{
+			name:  "bright_background",
+			style: Style{Background: BrightBlack},
+			want:  "\x1b[100m",
+		},
+		{
+			name:  "attributes",
+			style: Style{Foreground: BrightWhite, Background: Blue, Bold: true, Faint: true, Italic: true, Underline: true},
+			want:  "\x1b[1;2;3;4;97;44m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style.sgr(); got != tt.want {
+				t.Errorf("\ngot:\n%q\nwant:\n%q\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_stripANSI(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "plain",
+			s:    "running",
+			want: "running",
+		},
+		{
+			name: "colored",
+			s:    "\x1b[32mrunning\x1b[0m",
+			want: "running",
+		},
+		{
+			name: "multiple",
+			s:    "\x1b[1;31mstopped\x1b[0m (\x1b[33m3\x1b[0m)",
+			want: "stopped (3)",
+		},
+		{
+			name: "unterminated",
+			s:    "abc\x1b[31",
+			want: "abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripANSI(tt.s); got != tt.want {
+				t.Errorf("\ngot:\n%q\nwant:\n%q\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_stringWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{
+			name: "plain",
+			s:    "running",
+			want: 7,
+		},
+		{
+			name: "colored",
+			s:    "\x1b[32mrunning\x1b[0m",
+			want: 7,
+		},
+		{
+			name: "wide",
+			s:    "\x1b[31mあいう\x1b[0m",
+			want: 6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stringWidth(tt.s); got != tt.want {
+				t.Errorf("\ngot:\n%v\nwant:\n%v\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_styles(t *testing.T) {
+	data := Input{
+		Header: []string{"Name", "State", "Count"},
+		Data: [][]any{
+			{"web", "\x1b[32mrunning\x1b[0m", 10},
+			{"db", "\x1b[31mstopped\x1b[0m", 2},
+		},
+	}
+	type args struct {
+		opts []Option
+		v    any
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "colored_values",
+			args: args{
+				opts: []Option{WithFormat(CompressedTextFormat)},
+				v:    data,
+			},
+			want: "+------+---------+-------+\n" +
+				"| Name | State   | Count |\n" +
+				"+------+---------+-------+\n" +
+				"| web  | \x1b[32mrunning\x1b[0m |    10 |\n" +
+				"| db   | \x1b[31mstopped\x1b[0m |     2 |\n" +
+				"+------+---------+-------+\n",
+		},
+		{
+			name: "header_border_column",
+			args: args{
+				opts: []Option{
+					WithHeaderStyle(Style{Bold: true}),
+					WithBorderStyle(Style{Foreground: BrightBlack}),
+					WithColumnStyle(2, Style{Foreground: Cyan}),
+					WithIgnoreFields([]int{1}),
+				},
+				v: data,
+			},
+			want: "\x1b[90m+------+-------+\x1b[0m\n" +
+				"\x1b[90m|\x1b[0m \x1b[1mName\x1b[0m \x1b[90m|\x1b[0m \x1b[1mCount\x1b[0m \x1b[90m|\x1b[0m\n" +
+				"\x1b[90m+------+-------+\x1b[0m\n" +
+				"\x1b[90m|\x1b[0m web  \x1b[90m|\x1b[0m    10 \x1b[90m|\x1b[0m\n" +
+				"\x1b[90m+------+-------+\x1b[0m\n" +
+				"\x1b[90m|\x1b[0m db   \x1b[90m|\x1b[0m     2 \x1b[90m|\x1b[0m\n" +
+				"\x1b[90m+------+-------+\x1b[0m\n",
+		},
+		{
+			name: "column_index_after_ignore",
+			args: args{
+				opts: []Option{
+					WithColumnStyle(1, Style{Foreground: Cyan}),
+					WithIgnoreFields([]int{1}),
+					WithFormat(CompressedTextFormat),
+				},
+				v: data,
+			},
+			want: "+------+-------+\n" +
+				"| Name | Count |\n" +
+				"+------+-------+\n" +
+				"| web  |    \x1b[36m10\x1b[0m |\n" +
+				"| db   |     \x1b[36m2\x1b[0m |\n" +
+				"+------+-------+\n",
+		},
+		{
+			name: "vertical",
+			args: args{
+				opts: []Option{
+					WithFormat(VerticalFormat),
+					WithHeaderStyle(Style{Bold: true}),
+					WithBorderStyle(Style{Faint: true}),
+					WithIgnoreFields([]int{1}),
+				},
+				v: Input{
+					Header: data.Header,
+					Data:   data.Data[:1],
+				},
+			},
+			want: "\x1b[2m-[ RECORD 1 ]\x1b[0m\n" +
+				"\x1b[1mName\x1b[0m  \x1b[2m|\x1b[0m web\n" +
+				"\x1b[1mCount\x1b[0m \x1b[2m|\x1b[0m 10\n",
+		},
+		{
+			name: "markdown_unstyled",
+			args: args{
+				opts: []Option{
+					WithFormat(MarkdownFormat),
+					WithHeaderStyle(Style{Bold: true}),
+					WithBorderStyle(Style{Foreground: BrightBlack}),
+					WithColumnStyle(0, Style{Foreground: Cyan}),
+					WithIgnoreFields([]int{1}),
+				},
+				v: data,
+			},
+			want: "| Name | Count |\n" +
+				"|------|-------|\n" +
+				"| web  |    10 |\n" +
+				"| db   |     2 |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.args.opts...)
+			if err := table.Load(tt.args.v); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_cellStyle(t *testing.T) {
+	type check struct {
+		Name    string
+		Healthy bool
+		Cost    float64
+	}
+	data := []check{
+		{Name: "web", Healthy: true, Cost: 12.5},
+		{Name: "db", Healthy: false, Cost: 120},
+	}
+	style := func(row, col int, value any) Style {
+		switch v := value.(type) {
+		case bool:
+			if !v {
+				return Style{Foreground: Red, Bold: true, Marker: "! "}
+			}
+		case float64:
+			if v > 100 {
+				return Style{Background: Yellow}
+			}
+		}
+		return Style{}
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "text",
+			opts: []Option{WithFormat(CompressedTextFormat), WithCellStyle(style)},
+			want: "+------+---------+------+\n" +
+				"| Name | Healthy | Cost |\n" +
+				"+------+---------+------+\n" +
+				"| web  | true    | 12.5 |\n" +
+				"| db   | \x1b[1;31m! false\x1b[0m |  \x1b[43m120\x1b[0m |\n" +
+				"+------+---------+------+\n",
+		},
+		{
+			name: "markdown",
+			opts: []Option{WithFormat(MarkdownFormat), WithCellStyle(style)},
+			want: `| Name | Healthy                                     | Cost                                              |
+|------|---------------------------------------------|---------------------------------------------------|
+| web  | true                                        |                                              12.5 |
+| db   | <span style="color:red;">**! false**</span> | <span style="background-color:yellow;">120</span> |
+`,
+		},
+		{
+			name: "backlog",
+			opts: []Option{WithFormat(BacklogFormat), WithCellStyle(style)},
+			want: `| Name | Healthy                     | Cost                          |h
+| web  | true                        |                          12.5 |
+| db   | &color(red) { ''! false'' } | &color(black, yellow) { 120 } |
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(data); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_cellStyle_numeric(t *testing.T) {
+	in := Input{
+		Header: []string{"Cost"},
+		Data: [][]any{
+			{12345.25},
+			{5.5},
+			{1234.5},
+		},
+	}
+	style := func(row, col int, value any) Style {
+		if v, ok := value.(float64); ok && v < 10 {
+			return Style{Bold: true}
+		}
+		return Style{}
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "markdown",
+			opts: []Option{WithFormat(MarkdownFormat), WithCellStyle(style)},
+			want: `| Cost     |
+|----------|
+| 12345.25 |
+|  **5.5** |
+|   1234.5 |
+`,
+		},
+		{
+			name: "markdown_decimal_align",
+			opts: []Option{WithFormat(MarkdownFormat), WithCellStyle(style), WithDecimalAlign(true)},
+			want: `| Cost      |
+|-----------|
+| 12345.25  |
+|   **5.5** |
+|  1234.5   |
+`,
+		},
+		{
+			name: "backlog",
+			opts: []Option{WithFormat(BacklogFormat), WithCellStyle(style)},
+			want: `| Cost     |h
+| 12345.25 |
+|  ''5.5'' |
+|   1234.5 |
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(in); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_cellStyle_input(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := New(buf,
+		WithFormat(CompressedTextFormat),
+		WithMergeFields([]int{0}),
+		WithIgnoreFields([]int{1}),
+		WithCellStyle(func(row, col int, value any) Style {
+			if col == 0 || row == 0 {
+				return Style{Marker: "*"}
+			}
+			return Style{}
+		}),
+	)
+	err := table.Load(Input{
+		Header: []string{"Group", "Ignored", "Value"},
+		Data: [][]any{
+			{"a", "x", "a\nb"},
+			{"a", "x", "c"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := `+-------+-------+
+| Group | Value |
++-------+-------+
+| *a    | *a    |
+|       | b     |
+|       | c     |
++-------+-------+
+`
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestTable_stripe(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := New(buf,
+		WithFormat(CompressedTextFormat),
+		WithStripe(Style{Background: BrightBlack}),
+		WithColumnStyle(1, Style{Foreground: Green}),
+	)
+	err := table.Load(Input{
+		Header: []string{"Name", "Count"},
+		Data: [][]any{
+			{"a", 1},
+			{"b", 22},
+			{"c", 3},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := "+------+-------+\n" +
+		"| Name | Count |\n" +
+		"+------+-------+\n" +
+		"| a    |     \x1b[32m1\x1b[0m |\n" +
+		"|\x1b[100m b\x1b[100m    \x1b[0m|\x1b[100m    \x1b[32m22\x1b[0m\x1b[100m \x1b[0m|\n" +
+		"| c    |     \x1b[32m3\x1b[0m |\n" +
+		"+------+-------+\n"
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestTable_stripe_plain(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := New(buf,
+		WithFormat(PlainFormat),
+		WithStripe(Style{Background: BrightBlack}),
+	)
+	err := table.Load(Input{
+		Header: []string{"Name", "Note"},
+		Data: [][]any{
+			{"a", "x"},
+			{"bb", "long"},
+			{"c", "y"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table.Render()
+	want := "Name   Note\n" +
+		"a      x\n" +
+		"\x1b[100mbb\x1b[100m   \x1b[0m \x1b[100m long\x1b[100m\x1b[0m\n" +
+		"c      y\n"
+	if diff := cmp.Diff(buf.String(), want); diff != "" {
+		t.Error(diff)
+	}
+}
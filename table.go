@@ -2,8 +2,10 @@ package mintab
 
 import (
 	"io"
+	"reflect"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -28,6 +30,9 @@ const (
 	textNewLine     = "\n"
 	markdownNewLine = "<br>"
 	backlogNewLine  = "&br;"
+
+	checkMark = "✓"
+	crossMark = "✗"
 )
 
 // Input is a struct for loading values into Table.
@@ -40,13 +45,25 @@ type Input struct {
 type Table struct {
 	w                    io.Writer         // Destination for table output
 	r                    *strings.Replacer // Replacer for new lines in fields
-	format               Format            // Output table format: text|compressed-text|markdown|backlog
+	format               Format            // Output table format: text|compressed-text|markdown|backlog|vertical|plain
 	header               []string          // Table header after parsing
+	inputHeader          []string          // Header of the loaded input before ignoring fields
+	structType           reflect.Type      // Element type of the loaded struct slice
+	fieldNames           []string          // Names of the rendered struct fields
+	isRower              bool              // Whether the loaded struct implements Rower
+	inputColumns         []int             // Indices of the rendered columns in the header of Input or Rower
 	data                 [][][]string      // Matrix after parsing with each field divided by new lines
+	unstyled             [][][]string      // Lines of data before cell styles, for numeric detection
+	rawRows              []rawRow          // Formatted rows before merging, kept for sorting
+	sortFunc             SortFunc          // Comparison of rows to sort before rendering
+	parallelThreshold    int               // Number of rows from which fields are formatted in parallel, 0 to disable
+	isSorted             bool              // Whether data is built from the sorted raw rows
 	newLine              string            // New line string representation: "\n"|"<br>"|"&br;"
 	placeholder          string            // Placeholder for empty fields
 	wordDelimiter        string            // Delimiter for words within a field
 	colWidths            []int             // Max widths of each columns
+	intWidths            []int             // Max widths of the integer parts of numbers of each column
+	fracWidths           []int             // Max widths of the fractional parts of numbers of each column
 	lineHeights          []int             // Heights of lines with fields containing line breaks
 	numColumns           int               // Number of columns
 	numColumnsFirstRow   int               // Number of columns of the first data row
@@ -57,12 +74,90 @@ type Table struct {
 	marginWidthBothSides int               // Twice of margin size
 	margin               string            // Whitespaces around the field
 	hasHeader            bool              // Whether header rendering
+	isUpperCaseHeader    bool              // Whether header names are converted to upper case
 	isEscape             bool              // Whether HTML escaping (mainly designed for markdown)
 	isMerge              bool              // Track whether to merge fields
 	isBytesToString      bool              // Whether []uint8 should be treated as string
+	isDecimalAlign       bool              // Whether numbers are aligned on the decimal mark
+	isKeyValue           bool              // Whether a single struct or map is rendered as key-value pairs
 	prevRow              []string          // Retain previous row
 	mergedFields         []int             // Indices of columns to merge
 	ignoredFields        []int             // Indices of columns to ignore
+	columnFormatters     map[int]Formatter // Formatters of each column
+	typeFormatters       typeRegistry      // Formatters of each value type
+	conversions          []Conversion      // Precedence of interfaces converting values, nil for the default
+	timeLayout           string            // Layout of time.Time values
+	timeZone             *time.Location    // Location to which time.Time values are converted
+	isRelativeTime       bool              // Whether time.Time values are rendered relative to the current time
+	durationRounding     time.Duration     // Multiple to which time.Duration values are rounded
+	isDurationCompact    bool              // Whether zero units of time.Duration values are omitted
+	now                  func() time.Time  // Current time for relative times, nil for time.Now
+	defaultNumberFormat  *NumberFormat     // Format of numeric values in all columns
+	columnNumberFormats  numberFormats     // Formats of numeric values of each column
+	columnTypes          columnTypes       // Alignment types of each column
+	boolFormat           BoolFormat        // Rendering of bool values in all columns
+	columnBoolFormats    boolFormats       // Rendering of bool values of each column
+	filters              []Filter          // Predicates to select rows to be rendered
+	separator            Separator         // When borders are drawn between data rows
+	separatorInterval    int               // Number of rows between borders in interval mode
+	box                  *box              // Border characters in text formats, nil for the default
+	headerStyle          Style             // ANSI style of the header
+	borderStyle          Style             // ANSI style of the borders
+	columnStyles         map[int]Style     // ANSI styles of each column
+	cellStyle            StyleFunc         // Style of each cell based on its value
+	stripeStyle          Style             // ANSI style of alternating rows
+	headerSGR            string            // Escape sequence for the header style
+	borderSGR            string            // Escape sequence for the border style
+	stripeSGR            string            // Escape sequence for the stripe style
+	colSGRs              []string          // Escape sequences for the column styles
+	written              int64             // Number of bytes written in the current rendering
+	err                  error             // First write error in the current rendering
+	mu                   sync.Mutex        // Guards loading, appending and rendering
+}
+
+// A Formatter converts a raw field value to its string representation.
+type Formatter func(v any) string
+
+type typeRegistry map[reflect.Type]Formatter
+
+type numberFormats map[int]NumberFormat
+
+type columnTypes map[int]ColumnType
+
+type boolFormats map[int]BoolFormat
+
+// A SortFunc compares two rows of formatted fields of the rendered columns, as in slices.SortFunc.
+type SortFunc func(a, b []string) int
+
+type rawRow struct {
+	cells  []string   // Formatted fields
+	values []any      // Raw values for the cell style
+	lines  [][]string // Fields divided by new lines
+	widths []int      // Max widths of the fields
+}
+
+func (t *Table) newRawRow() rawRow {
+	return newRawRow(t.numColumns, t.cellStyle != nil)
+}
+
+func newRawRow(n int, hasValues bool) rawRow {
+	r := rawRow{
+		cells:  make([]string, n),
+		lines:  make([][]string, n),
+		widths: make([]int, n),
+	}
+	if hasValues {
+		r.values = make([]any, n)
+	}
+	return r
+}
+
+func (r *rawRow) set(i int, s string, v any) {
+	r.cells[i] = s
+	r.lines[i], r.widths[i] = measure(s)
+	if r.values != nil {
+		r.values[i] = v
+	}
 }
 
 // New instantiates a new Table with the writer and options.
@@ -95,6 +190,32 @@ func WithFormat(format Format) Option {
 	}
 }
 
+// WithTheme applies a named preset of format, border characters, row separators, header style and margins.
+// Options given after WithTheme override the preset.
+func WithTheme(theme Theme) Option {
+	return func(t *Table) {
+		t.applyTheme(theme)
+	}
+}
+
+// WithSortFunc sets the comparison to sort rows before rendering. Rows are sorted stably on
+// their formatted fields before merging, so the order does not depend on the order of AppendRow calls.
+func WithSortFunc(fn SortFunc) Option {
+	return func(t *Table) {
+		t.sortFunc = fn
+	}
+}
+
+// WithParallelThreshold sets the number of rows from which fields are formatted by multiple goroutines.
+// Parallel formatting is disabled by default, and zero or a negative value disables it. In parallel formatting,
+// filters, formatters, conversion methods of field values and other callbacks invoked while formatting may run
+// concurrently, so they must be safe for concurrent use.
+func WithParallelThreshold(n int) Option {
+	return func(t *Table) {
+		t.parallelThreshold = n
+	}
+}
+
 // WithHeader sets the table header.
 func WithHeader(has bool) Option {
 	return func(t *Table) {
@@ -102,6 +223,13 @@ func WithHeader(has bool) Option {
 	}
 }
 
+// WithUpperCaseHeader controls whether header names are converted to upper case.
+func WithUpperCaseHeader(has bool) Option {
+	return func(t *Table) {
+		t.isUpperCaseHeader = has
+	}
+}
+
 // WithMargin sets the margin size around field values.
 func WithMargin(width int) Option {
 	if width < 0 {
@@ -144,6 +272,112 @@ func WithIgnoreFields(indices []int) Option {
 	}
 }
 
+// WithColumnFormatter sets the formatter of the column at index col, which replaces the default
+// formatting of the raw field values. col is the index in the rendered table, after ignored fields are removed.
+// The result is sanitized as other fields, and right-aligned if it is numeric.
+// Formatters are not applied to rows of Rower, which are already formatted.
+// Neither are type formatters, conversions, or the time, number and bool formats.
+func WithColumnFormatter(col int, fn func(any) string) Option {
+	return func(t *Table) {
+		if t.columnFormatters == nil {
+			t.columnFormatters = make(map[int]Formatter)
+		}
+		t.columnFormatters[col] = fn
+	}
+}
+
+// WithTypeFormatter registers the formatter of values of type T, which replaces the default formatting
+// wherever a field or an element of a slice field has exactly type T. For example, it formats time.Time,
+// net.IP or a [16]byte UUID as a single value instead of the generic slice or Stringer formatting.
+// Column formatters take precedence over type formatters. Rows of Rower are not affected.
+func WithTypeFormatter[T any](fn func(T) string) Option {
+	typ := reflect.TypeFor[T]()
+	return func(t *Table) {
+		if t.typeFormatters == nil {
+			t.typeFormatters = make(typeRegistry)
+		}
+		t.typeFormatters[typ] = func(v any) string {
+			return fn(v.(T))
+		}
+	}
+}
+
+// WithConversions sets the interfaces through which field values are converted to strings, in order of precedence.
+// The default is DefaultConversions. Calling it without conversions disables them all.
+// Errors of MarshalText and MarshalJSON are returned as load errors.
+func WithConversions(conversions ...Conversion) Option {
+	return func(t *Table) {
+		t.conversions = append([]Conversion{}, conversions...)
+	}
+}
+
+// WithTimeLayout sets the layout of time.Time values, as in time.Time.Format.
+// The monotonic clock reading printed by time.Time.String is not included. Zero times are rendered as the placeholder.
+// This and the other time and duration options are ignored for rows of Rower.
+func WithTimeLayout(layout string) Option {
+	return func(t *Table) {
+		t.timeLayout = layout
+	}
+}
+
+// WithTimeZone converts time.Time values to loc before formatting them.
+// Without WithTimeLayout, DefaultTimeLayout is used.
+func WithTimeZone(loc *time.Location) Option {
+	return func(t *Table) {
+		t.timeZone = loc
+	}
+}
+
+// WithRelativeTime controls whether time.Time values are rendered relative to the current time in their largest unit,
+// such as "5m ago" or "in 2d", instead of with the layout.
+func WithRelativeTime(has bool) Option {
+	return func(t *Table) {
+		t.isRelativeTime = has
+	}
+}
+
+// WithDurationRounding rounds time.Duration values to the nearest multiple of d, as in time.Duration.Round.
+func WithDurationRounding(d time.Duration) Option {
+	return func(t *Table) {
+		t.durationRounding = d
+	}
+}
+
+// WithDurationCompact controls whether zero units of time.Duration values are omitted, such as "1h2m" instead of "1h2m0s".
+func WithDurationCompact(has bool) Option {
+	return func(t *Table) {
+		t.isDurationCompact = has
+	}
+}
+
+// WithNumberFormat sets the format of integer and float field values in all columns, including elements of slice fields.
+// Column and type formatters and conversions take precedence over number formats.
+// Numbers in rows of Rower are rendered as MintabRow returns them.
+func WithNumberFormat(format NumberFormat) Option {
+	return func(t *Table) {
+		t.defaultNumberFormat = &format
+	}
+}
+
+// WithColumnNumberFormat sets the format of integer and float field values in the column at index col,
+// overriding WithNumberFormat. col is the index in the rendered table, after ignored fields are removed.
+func WithColumnNumberFormat(col int, format NumberFormat) Option {
+	return func(t *Table) {
+		if t.columnNumberFormats == nil {
+			t.columnNumberFormats = make(numberFormats)
+		}
+		t.columnNumberFormats[col] = format
+	}
+}
+
+// WithFilter sets filters to select rows while loading.
+// A row is rendered only if all filters report true.
+func WithFilter(filters ...Filter) Option {
+	return func(t *Table) {
+		t.filters = append(t.filters, filters...)
+	}
+}
+
 // WithEscape enables or disables HTML escaping.
 func WithEscape(has bool) Option {
 	return func(t *Table) {
@@ -151,6 +385,45 @@ func WithEscape(has bool) Option {
 	}
 }
 
+// WithBoolFormat sets how bool values are rendered in all columns, including elements of slice fields.
+// It has no effect on rows of Rower.
+func WithBoolFormat(format BoolFormat) Option {
+	return func(t *Table) {
+		t.boolFormat = format
+	}
+}
+
+// WithColumnBoolFormat sets how bool values are rendered in the column at index col, overriding WithBoolFormat.
+// col is the index in the rendered table, after ignored fields are removed.
+func WithColumnBoolFormat(col int, format BoolFormat) Option {
+	return func(t *Table) {
+		if t.columnBoolFormats == nil {
+			t.columnBoolFormats = make(boolFormats)
+		}
+		t.columnBoolFormats[col] = format
+	}
+}
+
+// WithColumnType sets how the fields of the column at index col are aligned. col is the index in the rendered table,
+// after ignored fields are removed. By default, fields detected as numbers are right-aligned, including signed numbers,
+// numbers with thousands separators or exponents, infinities and NaN.
+func WithColumnType(col int, typ ColumnType) Option {
+	return func(t *Table) {
+		if t.columnTypes == nil {
+			t.columnTypes = make(columnTypes)
+		}
+		t.columnTypes[col] = typ
+	}
+}
+
+// WithDecimalAlign controls whether numeric fields are aligned on the decimal mark.
+// Fractional parts are padded so that the marks of each column line up, in all formats but vertical.
+func WithDecimalAlign(has bool) Option {
+	return func(t *Table) {
+		t.isDecimalAlign = has
+	}
+}
+
 // WithBytesAsString controls how []uint8 is interpreted.
 func WithBytesAsString(has bool) Option {
 	return func(t *Table) {
@@ -158,6 +431,73 @@ func WithBytesAsString(has bool) Option {
 	}
 }
 
+// WithKeyValue controls whether a single struct or map is rendered as a two-column Key/Value table.
+// Nested structs and maps are flattened into dotted keys.
+func WithKeyValue(has bool) Option {
+	return func(t *Table) {
+		t.isKeyValue = has
+	}
+}
+
+// WithRowSeparator sets when borders are drawn between data rows in text formats.
+func WithRowSeparator(sep Separator) Option {
+	return func(t *Table) {
+		t.separator = sep
+	}
+}
+
+// WithRowSeparatorInterval draws a border every n data rows in text formats.
+func WithRowSeparatorInterval(n int) Option {
+	if n < 1 {
+		n = 1
+	}
+	return func(t *Table) {
+		t.separator = IntervalSeparator
+		t.separatorInterval = n
+	}
+}
+
+// WithHeaderStyle sets the ANSI style of the header in text formats.
+func WithHeaderStyle(style Style) Option {
+	return func(t *Table) {
+		t.headerStyle = style
+	}
+}
+
+// WithBorderStyle sets the ANSI style of the borders in text formats.
+func WithBorderStyle(style Style) Option {
+	return func(t *Table) {
+		t.borderStyle = style
+	}
+}
+
+// WithColumnStyle sets the ANSI style of the column at index col in text formats.
+// col is the index in the rendered table, after ignored fields are removed.
+func WithColumnStyle(col int, style Style) Option {
+	return func(t *Table) {
+		if t.columnStyles == nil {
+			t.columnStyles = make(map[int]Style)
+		}
+		t.columnStyles[col] = style
+	}
+}
+
+// WithCellStyle sets a function that returns the style of each cell from its row index, column index and raw value.
+// Styles are rendered as ANSI escape sequences in text formats, as `**bold**` and `<span style>` in markdown,
+// and as backlog markup in backlog format.
+func WithCellStyle(fn StyleFunc) Option {
+	return func(t *Table) {
+		t.cellStyle = fn
+	}
+}
+
+// WithStripe sets the ANSI style of every other data row in text formats, such as an alternating background color.
+func WithStripe(style Style) Option {
+	return func(t *Table) {
+		t.stripeStyle = style
+	}
+}
+
 var bufPool = sync.Pool{
 	New: func() any {
 		return new(strings.Builder)
@@ -51,6 +51,26 @@ type stringerTestStruct struct {
 	NestedBytes [][]byte
 }
 
+type KeyValueTestEmbedded struct {
+	Region string
+}
+
+type keyValueTestPlacement struct {
+	AvailabilityZone string
+	Tenancy          string
+}
+
+type keyValueTestStruct struct {
+	KeyValueTestEmbedded
+	InstanceID string
+	Placement  keyValueTestPlacement
+	Monitoring *bool
+	Tags       map[string]string
+	Ports      []int
+	CPU        int
+	private    string
+}
+
 type nonExportedTestStruct struct {
 	f1 string
 	f2 string
@@ -1063,6 +1083,241 @@ func TestTable(t *testing.T) {
 | empty&nbsp;field&nbsp;placeholder | \-                                                                                                                                                                                                          |
 | html&nbsp;tag                     | &lt;span&nbsp;style=&quot;color:#d70910;&quot;&gt;red&lt;/span&gt;                                                                                                                                          |
 | JSON                              | {<br>&nbsp;&nbsp;&quot;key&quot;:&nbsp;[<br>&nbsp;&nbsp;&nbsp;&nbsp;&quot;value1&quot;,<br>&nbsp;&nbsp;&nbsp;&nbsp;&quot;value2&quot;,<br>&nbsp;&nbsp;&nbsp;&nbsp;&quot;value3&quot;,<br>&nbsp;&nbsp;]<br>} |
+`,
+			wantErr: false,
+		},
+		{
+			name: "input_vertical",
+			args: args{
+				opts: []Option{WithFormat(VerticalFormat)},
+				v:    basicTestInput,
+			},
+			want: `-[ RECORD 1 ]+---------
+InstanceID   | i-1
+InstanceName | server-1
+AttachedLB   | lb-1
+AttachedTG   | tg-1
+-[ RECORD 2 ]+---------
+InstanceID   | i-2
+InstanceName | server-2
+AttachedLB   | lb-2
+             | lb-3
+AttachedTG   | tg-2
+-[ RECORD 3 ]+---------
+InstanceID   | i-3
+InstanceName | server-3
+AttachedLB   | lb-4
+AttachedTG   | tg-3
+             | tg-4
+-[ RECORD 4 ]+---------
+InstanceID   | i-4
+InstanceName | server-4
+AttachedLB   | -
+AttachedTG   | -
+-[ RECORD 5 ]+---------
+InstanceID   | i-5
+InstanceName | server-5
+AttachedLB   | lb-5
+AttachedTG   | -
+-[ RECORD 6 ]+---------
+InstanceID   | i-6
+InstanceName | server-6
+AttachedLB   | -
+AttachedTG   | tg-5
+             | tg-6
+             | tg-7
+             | tg-8
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_vertical_no_header",
+			args: args{
+				opts: []Option{WithFormat(VerticalFormat), WithHeader(false), WithIgnoreFields([]int{2, 3})},
+				v:    basicTestStructSlice[:2],
+			},
+			want: `InstanceID   | i-1
+InstanceName | server-1
+
+InstanceID   | i-2
+InstanceName | server-2
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_vertical_short_header",
+			args: args{
+				opts: []Option{WithFormat(VerticalFormat)},
+				v: []struct {
+					ID   int
+					Name string
+				}{
+					{ID: 1, Name: "a"},
+					{ID: 2, Name: "b"},
+				},
+			},
+			want: `-[ RECORD 1 ]
+ID   | 1
+Name | a
+-[ RECORD 2 ]
+ID   | 2
+Name | b
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_key_value",
+			args: args{
+				opts: []Option{WithKeyValue(true)},
+				v: &keyValueTestStruct{
+					KeyValueTestEmbedded: KeyValueTestEmbedded{Region: "ap-northeast-1"},
+					InstanceID:           "i-1",
+					Placement: keyValueTestPlacement{
+						AvailabilityZone: "ap-northeast-1a",
+						Tenancy:          "default",
+					},
+					Monitoring: nil,
+					Tags:       map[string]string{"Name": "server-1", "Env": "prod"},
+					Ports:      []int{22, 443},
+					CPU:        2,
+				},
+			},
+			want: `+----------------------------+-----------------+
+| Key                        | Value           |
++----------------------------+-----------------+
+| Region                     | ap-northeast-1  |
++----------------------------+-----------------+
+| InstanceID                 | i-1             |
++----------------------------+-----------------+
+| Placement.AvailabilityZone | ap-northeast-1a |
++----------------------------+-----------------+
+| Placement.Tenancy          | default         |
++----------------------------+-----------------+
+| Monitoring                 | -               |
++----------------------------+-----------------+
+| Tags.Env                   | prod            |
++----------------------------+-----------------+
+| Tags.Name                  | server-1        |
++----------------------------+-----------------+
+| Ports                      |              22 |
+|                            |             443 |
++----------------------------+-----------------+
+| CPU                        |               2 |
++----------------------------+-----------------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "map_key_value",
+			args: args{
+				opts: []Option{WithKeyValue(true), WithFormat(MarkdownFormat)},
+				v: map[string]any{
+					"b": map[string]int{"y": 2, "x": 1},
+					"a": "aaa",
+					"c": map[string]int{},
+				},
+			},
+			want: `| Key | Value |
+|-----|-------|
+| a   | aaa   |
+| b.x |     1 |
+| b.y |     2 |
+| c   | \-    |
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_key_value_nil_embedded",
+			args: args{
+				opts: []Option{WithKeyValue(true), WithFormat(CompressedTextFormat)},
+				v: struct {
+					*KeyValueTestEmbedded
+					ID string
+				}{ID: "i-1"},
+			},
+			want: `+-----+-------+
+| Key | Value |
++-----+-------+
+| ID  | i-1   |
++-----+-------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "map_key_value_int_keys",
+			args: args{
+				opts: []Option{WithKeyValue(true), WithFormat(MarkdownFormat)},
+				v:    map[int]string{100: "c", 9: "a", 10: "b"},
+			},
+			want: `| Key | Value |
+|-----|-------|
+|   9 | a     |
+|  10 | b     |
+| 100 | c     |
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_slice_key_value",
+			args: args{
+				opts: []Option{WithKeyValue(true), WithFormat(CompressedTextFormat)},
+				v:    basicTestStructSlice[:1],
+			},
+			want: `+------------+--------------+------------+------------+
+| InstanceID | InstanceName | AttachedLB | AttachedTG |
++------------+--------------+------------+------------+
+| i-1        | server-1     | lb-1       | tg-1       |
++------------+--------------+------------+------------+
+`,
+			wantErr: false,
+		},
+		{
+			name: "input_plain",
+			args: args{
+				opts: []Option{WithFormat(PlainFormat), WithUpperCaseHeader(true), WithMergeFields([]int{0})},
+				v:    basicTestInput,
+			},
+			want: `INSTANCEID   INSTANCENAME   ATTACHEDLB   ATTACHEDTG
+i-1          server-1       lb-1         tg-1
+i-2          server-2       lb-2         tg-2
+                            lb-3
+i-3          server-3       lb-4         tg-3
+                                         tg-4
+i-4          server-4       -            -
+i-5          server-5       lb-5         -
+i-6          server-6       -            tg-5
+                                         tg-6
+                                         tg-7
+                                         tg-8
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_plain_no_header",
+			args: args{
+				opts: []Option{WithFormat(PlainFormat), WithHeader(false), WithMargin(0)},
+				v: []struct {
+					Name  string
+					Count int
+				}{
+					{Name: "web", Count: 10},
+					{Name: "database", Count: 2},
+				},
+			},
+			want: `web         10
+database     2
+`,
+			wantErr: false,
+		},
+		{
+			name: "struct_plain_upper_case_header",
+			args: args{
+				opts: []Option{WithFormat(PlainFormat), WithUpperCaseHeader(true), WithIgnoreFields([]int{2, 3})},
+				v:    basicTestStructSlice[:2],
+			},
+			want: `INSTANCEID   INSTANCENAME
+i-1          server-1
+i-2          server-2
 `,
 			wantErr: false,
 		},
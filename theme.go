@@ -0,0 +1,54 @@
+package mintab
+
+type box struct {
+	vertical   string // Column separator
+	horizontal string // Fill of border lines, or empty for no border lines
+	cross      string // Joint of border lines
+	edge       bool   // Whether the outer frame is drawn
+}
+
+var (
+	defaultBox = &box{vertical: "|", horizontal: "-", cross: "+", edge: true}
+	markupBox  = &box{vertical: "|", horizontal: "-", cross: "|", edge: true}
+	psqlBox    = &box{vertical: "|", horizontal: "-", cross: "+", edge: false}
+	plainBox   = &box{vertical: " ", horizontal: "", cross: "", edge: false}
+)
+
+func (t *Table) frame() *box {
+	switch {
+	case t.format == MarkdownFormat || t.format == BacklogFormat:
+		return markupBox
+	case t.format == PlainFormat:
+		return plainBox
+	case t.box == nil:
+		return defaultBox
+	default:
+		return t.box
+	}
+}
+
+func (t *Table) applyTheme(theme Theme) {
+	t.format = TextFormat
+	t.box = nil
+	t.separator = AutoSeparator
+	t.headerStyle = Style{}
+	t.marginWidth = 1
+	switch theme {
+	case PsqlTheme:
+		t.box = psqlBox
+		t.separator = NoSeparator
+	case MysqlTheme:
+		t.separator = NoSeparator
+	case GithubTheme:
+		t.format = MarkdownFormat
+	case CompactTheme:
+		t.separator = NoSeparator
+		t.headerStyle = Style{Bold: true}
+		t.marginWidth = 0
+	case MinimalTheme:
+		t.format = PlainFormat
+		t.box = plainBox
+		t.separator = NoSeparator
+	}
+	t.marginWidthBothSides = t.marginWidth * 2
+}
@@ -0,0 +1,154 @@
+package mintab
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTable_theme(t *testing.T) {
+	data := Input{
+		Header: []string{"NAME", "READY", "STATUS"},
+		Data: [][]any{
+			{"web", 1, "Running"},
+			{"db", 0, "Pending"},
+		},
+	}
+	type args struct {
+		opts []Option
+		v    any
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "default",
+			args: args{
+				opts: []Option{WithTheme(DefaultTheme)},
+				v:    data,
+			},
+			want: `+------+-------+---------+
+| NAME | READY | STATUS  |
++------+-------+---------+
+| web  |     1 | Running |
++------+-------+---------+
+| db   |     0 | Pending |
++------+-------+---------+
+`,
+		},
+		{
+			name: "psql",
+			args: args{
+				opts: []Option{WithTheme(PsqlTheme)},
+				v:    data,
+			},
+			want: " NAME | READY | STATUS  \n" +
+				"------+-------+---------\n" +
+				" web  |     1 | Running \n" +
+				" db   |     0 | Pending \n",
+		},
+		{
+			name: "mysql",
+			args: args{
+				opts: []Option{WithTheme(MysqlTheme)},
+				v:    data,
+			},
+			want: `+------+-------+---------+
+| NAME | READY | STATUS  |
++------+-------+---------+
+| web  |     1 | Running |
+| db   |     0 | Pending |
++------+-------+---------+
+`,
+		},
+		{
+			name: "github",
+			args: args{
+				opts: []Option{WithTheme(GithubTheme)},
+				v:    data,
+			},
+			want: `| NAME | READY | STATUS  |
+|------|-------|---------|
+| web  |     1 | Running |
+| db   |     0 | Pending |
+`,
+		},
+		{
+			name: "compact",
+			args: args{
+				opts: []Option{WithTheme(CompactTheme)},
+				v:    data,
+			},
+			want: "+----+-----+-------+\n" +
+				"|\x1b[1mNAME\x1b[0m|\x1b[1mREADY\x1b[0m|\x1b[1mSTATUS\x1b[0m |\n" +
+				"+----+-----+-------+\n" +
+				"|web |    1|Running|\n" +
+				"|db  |    0|Pending|\n" +
+				"+----+-----+-------+\n",
+		},
+		{
+			name: "minimal",
+			args: args{
+				opts: []Option{WithTheme(MinimalTheme)},
+				v:    data,
+			},
+			want: `NAME   READY   STATUS
+web        1   Running
+db         0   Pending
+`,
+		},
+		{
+			name: "psql_no_header",
+			args: args{
+				opts: []Option{WithTheme(PsqlTheme), WithHeader(false)},
+				v:    data,
+			},
+			want: " web  |     1 | Running \n" +
+				" db   |     0 | Pending \n",
+		},
+		{
+			name: "minimal_vertical",
+			args: args{
+				opts: []Option{WithTheme(MinimalTheme), WithFormat(VerticalFormat)},
+				v:    data,
+			},
+			want: `-[ RECORD 1 ]
+NAME     web
+READY    1
+STATUS   Running
+-[ RECORD 2 ]
+NAME     db
+READY    0
+STATUS   Pending
+`,
+		},
+		{
+			name: "override",
+			args: args{
+				opts: []Option{WithTheme(PsqlTheme), WithRowSeparator(EverySeparator)},
+				v:    data,
+			},
+			want: " NAME | READY | STATUS  \n" +
+				"------+-------+---------\n" +
+				" web  |     1 | Running \n" +
+				"------+-------+---------\n" +
+				" db   |     0 | Pending \n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.args.opts...)
+			if err := table.Load(tt.args.v); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
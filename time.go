@@ -0,0 +1,124 @@
+package mintab
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultTimeLayout is the layout of time.Time values when only WithTimeZone is set.
+// It is the layout of time.Time.String without the monotonic clock reading.
+const DefaultTimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+// formatTime formats time.Time and time.Duration values with the time options.
+// It reports false if rv has another type or no option applies to it.
+func (t *Table) formatTime(rv reflect.Value) (string, bool) {
+	switch rv.Type() {
+	case timeType:
+		if t.timeLayout == "" && t.timeZone == nil && !t.isRelativeTime {
+			return "", false
+		}
+		return t.formatTimeValue(rv.Interface().(time.Time)), true
+	case durationType:
+		if t.durationRounding <= 0 && !t.isDurationCompact {
+			return "", false
+		}
+		return t.formatDuration(time.Duration(rv.Int())), true
+	default:
+		return "", false
+	}
+}
+
+// formatTimeValue formats v with the time options. Zero times are formatted as an empty string,
+// which is rendered as the placeholder without being sanitized.
+func (t *Table) formatTimeValue(v time.Time) string {
+	if v.IsZero() {
+		return ""
+	}
+	if t.isRelativeTime {
+		now := time.Now
+		if t.now != nil {
+			now = t.now
+		}
+		return relativeTime(now().Sub(v))
+	}
+	if t.timeZone != nil {
+		v = v.In(t.timeZone)
+	}
+	layout := t.timeLayout
+	if layout == "" {
+		layout = DefaultTimeLayout
+	}
+	return v.Format(layout)
+}
+
+func (t *Table) formatDuration(d time.Duration) string {
+	if t.durationRounding > 0 {
+		d = d.Round(t.durationRounding)
+	}
+	if t.isDurationCompact {
+		return compactDuration(d)
+	}
+	return d.String()
+}
+
+// relativeTime formats d elapsed since a time in its largest unit, such as "5m ago", or "in 2d" if d is negative.
+func relativeTime(d time.Duration) string {
+	future := d < 0
+	if future {
+		d = -d
+	}
+	var n time.Duration
+	var unit string
+	switch {
+	case d < time.Minute:
+		n, unit = d/time.Second, "s"
+	case d < time.Hour:
+		n, unit = d/time.Minute, "m"
+	case d < 24*time.Hour:
+		n, unit = d/time.Hour, "h"
+	default:
+		n, unit = d/(24*time.Hour), "d"
+	}
+	s := strconv.FormatInt(int64(n), 10) + unit
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
+
+// compactDuration formats d as time.Duration.String but omits zero units, such as "1h2m" instead of "1h2m0s".
+func compactDuration(d time.Duration) string {
+	if d > -time.Second && d < time.Second || d == time.Duration(-1<<63) {
+		return d.String()
+	}
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	if h := d / time.Hour; h > 0 {
+		b.WriteString(strconv.FormatInt(int64(h), 10))
+		b.WriteByte('h')
+	}
+	if m := d / time.Minute % 60; m > 0 {
+		b.WriteString(strconv.FormatInt(int64(m), 10))
+		b.WriteByte('m')
+	}
+	if s := d % time.Minute; s > 0 {
+		b.WriteString(strconv.FormatInt(int64(s/time.Second), 10))
+		if ns := s % time.Second; ns > 0 {
+			frac := strconv.FormatInt(int64(ns)+int64(time.Second), 10)[1:]
+			b.WriteByte('.')
+			b.WriteString(strings.TrimRight(frac, "0"))
+		}
+		b.WriteByte('s')
+	}
+	return b.String()
+}
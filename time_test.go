@@ -0,0 +1,211 @@
+package mintab
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTable_formatTime(t *testing.T) {
+	type job struct {
+		Name     string
+		Started  time.Time
+		Finished *time.Time
+		Elapsed  time.Duration
+		Steps    []time.Duration
+	}
+	now := time.Date(2026, 10, 16, 9, 0, 0, 123456789, time.UTC)
+	finished := now.Add(-90 * time.Minute)
+	jobs := []job{
+		{
+			Name:     "build",
+			Started:  now.Add(-3 * time.Hour),
+			Finished: &finished,
+			Elapsed:  time.Hour + 2*time.Minute + 345*time.Millisecond,
+			Steps:    []time.Duration{time.Hour, 90 * time.Second},
+		},
+		{
+			Name:    "deploy",
+			Started: now.Add(2 * 24 * time.Hour),
+			Elapsed: 1500 * time.Millisecond,
+		},
+	}
+	jst := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "default",
+			opts: []Option{WithFormat(CompressedTextFormat), WithIgnoreFields([]int{2, 4})},
+			want: `+--------+-----------------------------------------+------------+
+| Name   | Started                                 | Elapsed    |
++--------+-----------------------------------------+------------+
+| build  | 2026-10-16 06:00:00.123456789 +0000 UTC | 1h2m0.345s |
+| deploy | 2026-10-18 09:00:00.123456789 +0000 UTC | 1.5s       |
++--------+-----------------------------------------+------------+
+`,
+		},
+		{
+			name: "layout",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithTimeLayout(time.DateTime),
+				WithDurationRounding(time.Second),
+				WithDurationCompact(true),
+			},
+			want: `+--------+---------------------+---------------------+---------+-------+
+| Name   | Started             | Finished            | Elapsed | Steps |
++--------+---------------------+---------------------+---------+-------+
+| build  | 2026-10-16 06:00:00 | 2026-10-16 07:30:00 | 1h2m    | 1h    |
+|        |                     |                     |         | 1m30s |
+| deploy | 2026-10-18 09:00:00 | -                   | 2s      | -     |
++--------+---------------------+---------------------+---------+-------+
+`,
+		},
+		{
+			name: "zone",
+			opts: []Option{WithFormat(CompressedTextFormat), WithTimeZone(jst), WithIgnoreFields([]int{2, 3, 4})},
+			want: `+--------+-----------------------------------------+
+| Name   | Started                                 |
++--------+-----------------------------------------+
+| build  | 2026-10-16 15:00:00.123456789 +0900 JST |
+| deploy | 2026-10-18 18:00:00.123456789 +0900 JST |
++--------+-----------------------------------------+
+`,
+		},
+		{
+			name: "relative",
+			opts: []Option{WithFormat(CompressedTextFormat), WithRelativeTime(true), WithIgnoreFields([]int{3, 4})},
+			want: `+--------+---------+----------+
+| Name   | Started | Finished |
++--------+---------+----------+
+| build  | 3h ago  | 1h ago   |
+| deploy | in 2d   | -        |
++--------+---------+----------+
+`,
+		},
+		{
+			name: "type_formatter",
+			opts: []Option{
+				WithFormat(CompressedTextFormat),
+				WithTimeLayout(time.DateTime),
+				WithTypeFormatter(func(v time.Time) string { return v.Format(time.Kitchen) }),
+				WithIgnoreFields([]int{3, 4}),
+			},
+			want: `+--------+---------+----------+
+| Name   | Started | Finished |
++--------+---------+----------+
+| build  | 6:00AM  | 7:30AM   |
+| deploy | 9:00AM  | -        |
++--------+---------+----------+
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			table.now = func() time.Time { return now }
+			if err := table.Load(jobs); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestTable_formatTime_zero(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+	}
+	at := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	events := []event{
+		{Name: "a", At: at},
+		{Name: "b"},
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "markdown_escape",
+			opts: []Option{WithFormat(MarkdownFormat), WithEscape(true), WithTimeLayout(time.DateOnly)},
+			want: `| Name | At         |
+|------|------------|
+| a    | 2026-10-16 |
+| b    | \-         |
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			table := New(buf, tt.opts...)
+			if err := table.Load(events); err != nil {
+				t.Fatal(err)
+			}
+			table.Render()
+			if diff := cmp.Diff(buf.String(), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_relativeTime(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{name: "now", d: 0, want: "0s ago"},
+		{name: "seconds", d: 59 * time.Second, want: "59s ago"},
+		{name: "minutes", d: 5*time.Minute + 59*time.Second, want: "5m ago"},
+		{name: "hours", d: 23 * time.Hour, want: "23h ago"},
+		{name: "days", d: 100 * 24 * time.Hour, want: "100d ago"},
+		{name: "future", d: -90 * time.Second, want: "in 1m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(relativeTime(tt.d), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func Test_compactDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{name: "zero", d: 0, want: "0s"},
+		{name: "sub_second", d: 1500 * time.Microsecond, want: "1.5ms"},
+		{name: "seconds", d: 90 * time.Second, want: "1m30s"},
+		{name: "hours", d: 2 * time.Hour, want: "2h"},
+		{name: "hours_seconds", d: time.Hour + 5*time.Second, want: "1h5s"},
+		{name: "fraction", d: time.Minute + 1500*time.Millisecond, want: "1m1.5s"},
+		{name: "sub_second_remainder", d: time.Hour + 30*time.Minute + 500*time.Millisecond, want: "1h30m0.5s"},
+		{name: "minute_sub_second", d: time.Minute + 500*time.Millisecond, want: "1m0.5s"},
+		{name: "nanosecond_remainder", d: time.Hour + time.Nanosecond, want: "1h0.000000001s"},
+		{name: "negative", d: -(time.Hour + 2*time.Minute), want: "-1h2m"},
+		{name: "min", d: time.Duration(-1 << 63), want: "-2562047h47m16.854775808s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(compactDuration(tt.d), tt.want); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}